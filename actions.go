@@ -1,11 +1,21 @@
 package polkassembly
 
 import (
+	"context"
 	"fmt"
 	"strings"
 )
 
-func (c *Client) AddComment(proposalType string, postID int, req AddCommentRequest) (*Comment, error) {
+func (c *Client) AddComment(proposalType ProposalType, postID int, req AddCommentRequest) (*Comment, error) {
+	return c.AddCommentCtx(context.Background(), proposalType, postID, req)
+}
+
+func (c *Client) AddCommentCtx(ctx context.Context, proposalType ProposalType, postID int, req AddCommentRequest) (*Comment, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+
 	var resp Comment
 	endpoint := fmt.Sprintf("/%s/%d/comments", proposalType, postID)
 
@@ -21,7 +31,7 @@ func (c *Client) AddComment(proposalType string, postID int, req AddCommentReque
 		body["address"] = req.Address
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(body).
 		Post(endpoint)
 
@@ -36,11 +46,20 @@ func (c *Client) AddComment(proposalType string, postID int, req AddCommentReque
 	return &resp, nil
 }
 
-func (c *Client) UpdateComment(proposalType string, postID int, commentID string, content interface{}) (*Comment, error) {
+func (c *Client) UpdateComment(proposalType ProposalType, postID int, commentID string, content interface{}) (*Comment, error) {
+	return c.UpdateCommentCtx(context.Background(), proposalType, postID, commentID, content)
+}
+
+func (c *Client) UpdateCommentCtx(ctx context.Context, proposalType ProposalType, postID int, commentID string, content interface{}) (*Comment, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+
 	var resp Comment
 	endpoint := fmt.Sprintf("/%s/%d/comments/%s", proposalType, postID, commentID)
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(map[string]interface{}{
 			"content": content,
 		}).
@@ -65,11 +84,20 @@ func (c *Client) UpdateComment(proposalType string, postID int, commentID string
 	return &resp, nil
 }
 
-func (c *Client) AddReaction(proposalType string, postID int, reaction string) (*Reaction, error) {
+func (c *Client) AddReaction(proposalType ProposalType, postID int, reaction string) (*Reaction, error) {
+	return c.AddReactionCtx(context.Background(), proposalType, postID, reaction)
+}
+
+func (c *Client) AddReactionCtx(ctx context.Context, proposalType ProposalType, postID int, reaction string) (*Reaction, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+
 	var resp Reaction
 	endpoint := fmt.Sprintf("/%s/%d/reactions", proposalType, postID)
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(map[string]interface{}{
 			"reaction": reaction,
 		}).
@@ -102,10 +130,19 @@ func (c *Client) AddReaction(proposalType string, postID int, reaction string) (
 	return &resp, nil
 }
 
-func (c *Client) DeleteComment(proposalType string, postID int, commentID string) error {
+func (c *Client) DeleteComment(proposalType ProposalType, postID int, commentID string) error {
+	return c.DeleteCommentCtx(context.Background(), proposalType, postID, commentID)
+}
+
+func (c *Client) DeleteCommentCtx(ctx context.Context, proposalType ProposalType, postID int, commentID string) error {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return err
+	}
+
 	endpoint := fmt.Sprintf("/%s/%d/comments/%s", proposalType, postID, commentID)
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		Delete(endpoint)
 
 	if err != nil {
@@ -115,7 +152,16 @@ func (c *Client) DeleteComment(proposalType string, postID int, commentID string
 	return c.parseResponse(r, nil)
 }
 
-func (c *Client) DeleteReaction(proposalType string, postID int, reactionID string) error {
+func (c *Client) DeleteReaction(proposalType ProposalType, postID int, reactionID string) error {
+	return c.DeleteReactionCtx(context.Background(), proposalType, postID, reactionID)
+}
+
+func (c *Client) DeleteReactionCtx(ctx context.Context, proposalType ProposalType, postID int, reactionID string) error {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return err
+	}
+
 	// API might not support DELETE with ID, try removing reaction by type
 	endpoint := fmt.Sprintf("/%s/%d/reactions", proposalType, postID)
 
@@ -131,7 +177,7 @@ func (c *Client) DeleteReaction(proposalType string, postID int, reactionID stri
 		reaction = reactionID
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(map[string]interface{}{
 			"reaction": reaction,
 		}).
@@ -145,7 +191,11 @@ func (c *Client) DeleteReaction(proposalType string, postID int, reactionID stri
 }
 
 func (c *Client) FollowUser(userID int) error {
-	r, err := c.client.R().
+	return c.FollowUserCtx(context.Background(), userID)
+}
+
+func (c *Client) FollowUserCtx(ctx context.Context, userID int) error {
+	r, err := c.newRequest(ctx).
 		Post(fmt.Sprintf("/users/id/%d/followers", userID))
 
 	if err != nil {
@@ -156,7 +206,11 @@ func (c *Client) FollowUser(userID int) error {
 }
 
 func (c *Client) UnfollowUser(userID int) error {
-	r, err := c.client.R().
+	return c.UnfollowUserCtx(context.Background(), userID)
+}
+
+func (c *Client) UnfollowUserCtx(ctx context.Context, userID int) error {
+	r, err := c.newRequest(ctx).
 		Delete(fmt.Sprintf("/users/id/%d/followers", userID))
 
 	if err != nil {
@@ -166,8 +220,17 @@ func (c *Client) UnfollowUser(userID int) error {
 	return c.parseResponse(r, nil)
 }
 
-func (c *Client) SubscribeProposal(proposalType string, postID int) error {
-	r, err := c.client.R().
+func (c *Client) SubscribeProposal(proposalType ProposalType, postID int) error {
+	return c.SubscribeProposalCtx(context.Background(), proposalType, postID)
+}
+
+func (c *Client) SubscribeProposalCtx(ctx context.Context, proposalType ProposalType, postID int) error {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return err
+	}
+
+	r, err := c.newRequest(ctx).
 		Post(fmt.Sprintf("/%s/%d/subscription", proposalType, postID))
 
 	if err != nil {
@@ -177,8 +240,17 @@ func (c *Client) SubscribeProposal(proposalType string, postID int) error {
 	return c.parseResponse(r, nil)
 }
 
-func (c *Client) UnsubscribeProposal(proposalType string, postID int) error {
-	r, err := c.client.R().
+func (c *Client) UnsubscribeProposal(proposalType ProposalType, postID int) error {
+	return c.UnsubscribeProposalCtx(context.Background(), proposalType, postID)
+}
+
+func (c *Client) UnsubscribeProposalCtx(ctx context.Context, proposalType ProposalType, postID int) error {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return err
+	}
+
+	r, err := c.newRequest(ctx).
 		Delete(fmt.Sprintf("/%s/%d/subscription", proposalType, postID))
 
 	if err != nil {
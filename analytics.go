@@ -0,0 +1,225 @@
+package polkassembly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const topCommentersLimit = 10
+
+// GetProposalAnalytics retrieves engagement analytics for a post. Fields the
+// API does not provide are computed client-side from comments, votes and
+// reactions.
+func (c *Client) GetProposalAnalytics(proposalType ProposalType, postID int) (*ProposalAnalytics, error) {
+	return c.GetProposalAnalyticsCtx(context.Background(), proposalType, postID)
+}
+
+// GetProposalAnalyticsCtx is like GetProposalAnalytics but carries ctx for cancellation and deadlines
+func (c *Client) GetProposalAnalyticsCtx(ctx context.Context, proposalType ProposalType, postID int) (*ProposalAnalytics, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := c.newRequest(ctx).
+		Get(fmt.Sprintf("/%s/%d/analytics", proposalType, postID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ProposalAnalytics
+	if err := c.parseResponse(r, &resp); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return c.ComputeProposalAnalyticsCtx(ctx, proposalType, postID)
+		}
+		return nil, err
+	}
+
+	if len(resp.DailyStats) > 0 && len(resp.VoterBreakdown) > 0 && len(resp.TopCommenters) > 0 {
+		return &resp, nil
+	}
+
+	computed, err := c.ComputeProposalAnalyticsCtx(ctx, proposalType, postID)
+	if err != nil {
+		return nil, err
+	}
+	resp.fillFrom(computed)
+
+	return &resp, nil
+}
+
+// ComputeProposalAnalytics builds analytics for a post entirely client-side
+func (c *Client) ComputeProposalAnalytics(proposalType ProposalType, postID int) (*ProposalAnalytics, error) {
+	return c.ComputeProposalAnalyticsCtx(context.Background(), proposalType, postID)
+}
+
+// ComputeProposalAnalyticsCtx is like ComputeProposalAnalytics but carries ctx for cancellation and deadlines
+func (c *Client) ComputeProposalAnalyticsCtx(ctx context.Context, proposalType ProposalType, postID int) (*ProposalAnalytics, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := c.GetPostByTypeCtx(ctx, postID, proposalType)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := c.GetPostCommentsByTypeCtx(ctx, postID, proposalType)
+	if err != nil {
+		return nil, fmt.Errorf("load comments: %w", err)
+	}
+
+	var votes []Vote
+	if proposalType.HasVotes() {
+		for vote, err := range c.AllVotes(ctx, VoteListingParams{PostID: postID}, proposalType, PageOptions{PageSize: 100}) {
+			if err != nil {
+				if errors.Is(err, ErrNotFound) {
+					break
+				}
+				return nil, fmt.Errorf("load votes: %w", err)
+			}
+			votes = append(votes, vote)
+		}
+	}
+
+	// Reactions only contribute to daily stats when the API lists them
+	reactions, _ := c.getReactionsCtx(ctx, proposalType, postID)
+
+	a := aggregateAnalytics(postID, comments, votes, reactions)
+	a.ViewCount = post.ViewsCount
+	if reactionCount := post.Metrics.Reactions.Like + post.Metrics.Reactions.Dislike; reactionCount > a.ReactionCount {
+		a.ReactionCount = reactionCount
+	}
+
+	return a, nil
+}
+
+func (c *Client) getReactionsCtx(ctx context.Context, proposalType ProposalType, postID int) ([]Reaction, error) {
+	r, err := c.newRequest(ctx).
+		Get(fmt.Sprintf("/%s/%d/reactions", proposalType, postID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Reactions []Reaction `json:"reactions"`
+	}
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Reactions, nil
+}
+
+// aggregateAnalytics computes counts, per-day stats, the voter breakdown by
+// decision and the most active commenters
+func aggregateAnalytics(postID int, comments []Comment, votes []Vote, reactions []Reaction) *ProposalAnalytics {
+	a := &ProposalAnalytics{
+		PostID:         postID,
+		VoteCount:      len(votes),
+		ReactionCount:  len(reactions),
+		VoterBreakdown: make(map[string]int),
+	}
+
+	days := make(map[time.Time]*DailyStat)
+	day := func(t time.Time) *DailyStat {
+		d := t.UTC().Truncate(24 * time.Hour)
+		if _, ok := days[d]; !ok {
+			days[d] = &DailyStat{Date: d}
+		}
+		return days[d]
+	}
+
+	commenters := make(map[string]int)
+	var walk func([]Comment)
+	walk = func(list []Comment) {
+		for _, cm := range list {
+			if cm.IsDeleted {
+				continue
+			}
+			a.CommentCount++
+			if !cm.CreatedAt.IsZero() {
+				day(cm.CreatedAt).Comments++
+			}
+			if cm.Username != "" {
+				commenters[cm.Username]++
+			}
+			walk(cm.Replies)
+		}
+	}
+	walk(comments)
+
+	for _, v := range votes {
+		decision := strings.ToLower(v.Decision)
+		if decision == "" {
+			decision = strings.ToLower(v.Vote)
+		}
+		if decision != "" {
+			a.VoterBreakdown[decision]++
+		}
+		if !v.CreatedAt.IsZero() {
+			day(v.CreatedAt).Votes++
+		}
+	}
+
+	for _, r := range reactions {
+		if !r.CreatedAt.IsZero() {
+			day(r.CreatedAt).Reactions++
+		}
+	}
+
+	for _, d := range days {
+		a.DailyStats = append(a.DailyStats, *d)
+	}
+	sort.Slice(a.DailyStats, func(i, j int) bool {
+		return a.DailyStats[i].Date.Before(a.DailyStats[j].Date)
+	})
+
+	for username, count := range commenters {
+		a.TopCommenters = append(a.TopCommenters, UserStat{Username: username, Count: count})
+	}
+	sort.Slice(a.TopCommenters, func(i, j int) bool {
+		if a.TopCommenters[i].Count != a.TopCommenters[j].Count {
+			return a.TopCommenters[i].Count > a.TopCommenters[j].Count
+		}
+		return a.TopCommenters[i].Username < a.TopCommenters[j].Username
+	})
+	if len(a.TopCommenters) > topCommentersLimit {
+		a.TopCommenters = a.TopCommenters[:topCommentersLimit]
+	}
+
+	return a
+}
+
+// fillFrom copies computed values into fields the API left empty
+func (a *ProposalAnalytics) fillFrom(computed *ProposalAnalytics) {
+	if a.PostID == 0 {
+		a.PostID = computed.PostID
+	}
+	if a.ViewCount == 0 {
+		a.ViewCount = computed.ViewCount
+	}
+	if a.CommentCount == 0 {
+		a.CommentCount = computed.CommentCount
+	}
+	if a.ReactionCount == 0 {
+		a.ReactionCount = computed.ReactionCount
+	}
+	if a.VoteCount == 0 {
+		a.VoteCount = computed.VoteCount
+	}
+	if len(a.DailyStats) == 0 {
+		a.DailyStats = computed.DailyStats
+	}
+	if len(a.VoterBreakdown) == 0 {
+		a.VoterBreakdown = computed.VoterBreakdown
+	}
+	if len(a.TopCommenters) == 0 {
+		a.TopCommenters = computed.TopCommenters
+	}
+}
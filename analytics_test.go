@@ -0,0 +1,46 @@
+package polkassembly
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAggregateAnalytics(t *testing.T) {
+	day1 := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+
+	comments := []Comment{
+		{Username: "alice", CreatedAt: day1, Replies: []Comment{
+			{Username: "bob", CreatedAt: day2},
+			{Username: "alice", CreatedAt: day2},
+		}},
+		{Username: "carol", CreatedAt: day2, IsDeleted: true},
+	}
+	votes := []Vote{
+		{Decision: "aye", CreatedAt: day1},
+		{Decision: "Aye", CreatedAt: day2},
+		{Decision: "nay", CreatedAt: day2},
+	}
+	reactions := []Reaction{{Reaction: "like", CreatedAt: day1}}
+
+	a := aggregateAnalytics(7, comments, votes, reactions)
+
+	if a.CommentCount != 3 || a.VoteCount != 3 || a.ReactionCount != 1 {
+		t.Fatalf("unexpected counts: %+v", a)
+	}
+	if a.VoterBreakdown["aye"] != 2 || a.VoterBreakdown["nay"] != 1 {
+		t.Errorf("unexpected breakdown: %v", a.VoterBreakdown)
+	}
+	if len(a.DailyStats) != 2 {
+		t.Fatalf("expected 2 days, got %d", len(a.DailyStats))
+	}
+	if d := a.DailyStats[0]; d.Comments != 1 || d.Votes != 1 || d.Reactions != 1 {
+		t.Errorf("unexpected first day: %+v", d)
+	}
+	if d := a.DailyStats[1]; d.Comments != 2 || d.Votes != 2 {
+		t.Errorf("unexpected second day: %+v", d)
+	}
+	if len(a.TopCommenters) != 2 || a.TopCommenters[0] != (UserStat{Username: "alice", Count: 2}) {
+		t.Errorf("unexpected top commenters: %v", a.TopCommenters)
+	}
+}
@@ -1,15 +1,22 @@
 package polkassembly
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 func (c *Client) Web3Auth(req Web3AuthRequest) (*Web3AuthResponse, error) {
+	return c.Web3AuthCtx(context.Background(), req)
+}
+
+func (c *Client) Web3AuthCtx(ctx context.Context, req Web3AuthRequest) (*Web3AuthResponse, error) {
 	var resp Web3AuthResponse
 
 	if req.Network == "" {
-		req.Network = c.network
+		req.Network = c.Network()
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(req).
 		Post("/auth/web3-auth")
 
@@ -25,12 +32,14 @@ func (c *Client) Web3Auth(req Web3AuthRequest) (*Web3AuthResponse, error) {
 
 	// Store all cookies from auth response
 	for _, cookie := range r.Cookies() {
-		c.client.SetCookie(cookie)
+		c.setCookie(cookie)
 		c.logDebug("Storing cookie: %s", cookie.Name)
 
 		if cookie.Name == "access_token" {
 			resp.Token = cookie.Value
-			c.SetAuthToken(cookie.Value)
+			if err := c.SetAuthToken(cookie.Value); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -38,9 +47,13 @@ func (c *Client) Web3Auth(req Web3AuthRequest) (*Web3AuthResponse, error) {
 }
 
 func (c *Client) Web2Login(req Web2LoginRequest) (*Web2LoginResponse, error) {
+	return c.Web2LoginCtx(context.Background(), req)
+}
+
+func (c *Client) Web2LoginCtx(ctx context.Context, req Web2LoginRequest) (*Web2LoginResponse, error) {
 	var resp Web2LoginResponse
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(req).
 		Post("/auth/web2-auth/login")
 
@@ -52,14 +65,20 @@ func (c *Client) Web2Login(req Web2LoginRequest) (*Web2LoginResponse, error) {
 		return nil, err
 	}
 
-	c.handleAuthResponse(resp.Token)
+	if err := c.handleAuthResponse(resp.Token); err != nil {
+		return nil, err
+	}
 	return &resp, nil
 }
 
 func (c *Client) Web2Signup(req Web2SignupRequest) (*Web2SignupResponse, error) {
+	return c.Web2SignupCtx(context.Background(), req)
+}
+
+func (c *Client) Web2SignupCtx(ctx context.Context, req Web2SignupRequest) (*Web2SignupResponse, error) {
 	var resp Web2SignupResponse
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(req).
 		Post("/auth/web2-auth/signup")
 
@@ -71,12 +90,18 @@ func (c *Client) Web2Signup(req Web2SignupRequest) (*Web2SignupResponse, error)
 		return nil, err
 	}
 
-	c.handleAuthResponse(resp.Token)
+	if err := c.handleAuthResponse(resp.Token); err != nil {
+		return nil, err
+	}
 	return &resp, nil
 }
 
 func (c *Client) SendResetPasswordEmail(req ResetPasswordRequest) error {
-	r, err := c.client.R().
+	return c.SendResetPasswordEmailCtx(context.Background(), req)
+}
+
+func (c *Client) SendResetPasswordEmailCtx(ctx context.Context, req ResetPasswordRequest) error {
+	r, err := c.newRequest(ctx).
 		SetBody(req).
 		Post("/auth/send-reset-password-email")
 
@@ -88,7 +113,11 @@ func (c *Client) SendResetPasswordEmail(req ResetPasswordRequest) error {
 }
 
 func (c *Client) ResetPasswordWithToken(token, newPassword string) error {
-	r, err := c.client.R().
+	return c.ResetPasswordWithTokenCtx(context.Background(), token, newPassword)
+}
+
+func (c *Client) ResetPasswordWithTokenCtx(ctx context.Context, token, newPassword string) error {
+	r, err := c.newRequest(ctx).
 		SetBody(map[string]string{
 			"token":       token,
 			"newPassword": newPassword,
@@ -103,9 +132,13 @@ func (c *Client) ResetPasswordWithToken(token, newPassword string) error {
 }
 
 func (c *Client) GenerateQRSession() (*QRSessionResponse, error) {
+	return c.GenerateQRSessionCtx(context.Background())
+}
+
+func (c *Client) GenerateQRSessionCtx(ctx context.Context) (*QRSessionResponse, error) {
 	var resp QRSessionResponse
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		Get("/auth/qr-session")
 
 	if err != nil {
@@ -120,9 +153,13 @@ func (c *Client) GenerateQRSession() (*QRSessionResponse, error) {
 }
 
 func (c *Client) ClaimQRSession(req ClaimQRSessionRequest) (*Web3AuthResponse, error) {
+	return c.ClaimQRSessionCtx(context.Background(), req)
+}
+
+func (c *Client) ClaimQRSessionCtx(ctx context.Context, req ClaimQRSessionRequest) (*Web3AuthResponse, error) {
 	var resp Web3AuthResponse
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(req).
 		Post("/auth/qr-session")
 
@@ -134,14 +171,20 @@ func (c *Client) ClaimQRSession(req ClaimQRSessionRequest) (*Web3AuthResponse, e
 		return nil, err
 	}
 
-	c.handleAuthResponse(resp.Token)
+	if err := c.handleAuthResponse(resp.Token); err != nil {
+		return nil, err
+	}
 	return &resp, nil
 }
 
 func (c *Client) EditUserDetails(userID int, req EditUserDetailsRequest) (*User, error) {
+	return c.EditUserDetailsCtx(context.Background(), userID, req)
+}
+
+func (c *Client) EditUserDetailsCtx(ctx context.Context, userID int, req EditUserDetailsRequest) (*User, error) {
 	var resp User
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(req).
 		Patch(fmt.Sprintf("/users/id/%d", userID))
 
@@ -150,7 +193,7 @@ func (c *Client) EditUserDetails(userID int, req EditUserDetailsRequest) (*User,
 	}
 
 	if r.StatusCode() == 204 {
-		return c.GetUserByID(userID)
+		return c.GetUserByIDCtx(ctx, userID)
 	}
 
 	if err := c.parseResponse(r, &resp); err != nil {
@@ -0,0 +1,222 @@
+package polkassembly
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Token describes the native currency of a network
+type Token struct {
+	Symbol   string
+	Decimals int
+}
+
+var networkTokens = map[string]Token{
+	"polkadot": {Symbol: "DOT", Decimals: 10},
+	"kusama":   {Symbol: "KSM", Decimals: 12},
+	"westend":  {Symbol: "WND", Decimals: 12},
+	"rococo":   {Symbol: "ROC", Decimals: 12},
+	"paseo":    {Symbol: "PAS", Decimals: 10},
+}
+
+// NetworkToken returns the native token of a network
+func NetworkToken(network string) (Token, bool) {
+	t, ok := networkTokens[strings.ToLower(network)]
+	return t, ok
+}
+
+// Token returns the native token of the client's network. Unknown networks
+// yield a zero Token, which formats amounts in planck.
+func (c *Client) Token() Token {
+	t, _ := NetworkToken(c.Network())
+	return t
+}
+
+// Balance is an on-chain amount in the smallest unit (planck). It decodes
+// from JSON numbers and from decimal or hex strings, and encodes as a decimal
+// string. The zero value is 0. Balances are immutable; arithmetic returns a
+// new value.
+type Balance struct {
+	v *big.Int
+}
+
+// NewBalance returns a Balance of planck units
+func NewBalance(planck *big.Int) Balance {
+	if planck == nil {
+		return Balance{}
+	}
+	return Balance{v: new(big.Int).Set(planck)}
+}
+
+// NewBalanceInt64 returns a Balance of planck units
+func NewBalanceInt64(planck int64) Balance {
+	return Balance{v: big.NewInt(planck)}
+}
+
+// Int returns a copy of the planck amount
+func (b Balance) Int() *big.Int {
+	if b.v == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(b.v)
+}
+
+func (b Balance) IsZero() bool { return b.v == nil || b.v.Sign() == 0 }
+
+func (b Balance) Sign() int {
+	if b.v == nil {
+		return 0
+	}
+	return b.v.Sign()
+}
+
+// Cmp compares b and o and returns -1, 0 or +1
+func (b Balance) Cmp(o Balance) int { return b.Int().Cmp(o.Int()) }
+
+func (b Balance) Add(o Balance) Balance { return Balance{v: new(big.Int).Add(b.Int(), o.Int())} }
+
+func (b Balance) Sub(o Balance) Balance { return Balance{v: new(big.Int).Sub(b.Int(), o.Int())} }
+
+// String returns the planck amount in decimal
+func (b Balance) String() string { return b.Int().String() }
+
+// Format renders b in whole tokens, e.g. "12.5 DOT". Trailing zeros of the
+// fraction are dropped. A zero Token formats the planck amount.
+func (b Balance) Format(t Token) string {
+	s := b.formatUnits(t.Decimals)
+	if t.Symbol == "" {
+		return s
+	}
+	return s + " " + t.Symbol
+}
+
+func (b Balance) formatUnits(decimals int) string {
+	n := b.Int()
+	neg := n.Sign() < 0
+	n.Abs(n)
+
+	digits := n.String()
+	if decimals > 0 {
+		if len(digits) <= decimals {
+			digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+		}
+		whole, frac := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+		digits = whole
+		if frac != "" {
+			digits += "." + frac
+		}
+	}
+	if neg {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Parse converts a human amount such as "12.5" or "12.5 DOT" to planck. A
+// symbol, when present, must match t. Errors match ErrValidation.
+func (t Token) Parse(s string) (Balance, error) {
+	s = strings.TrimSpace(s)
+	if fields := strings.Fields(s); len(fields) == 2 {
+		if !strings.EqualFold(fields[1], t.Symbol) {
+			return Balance{}, fmt.Errorf("%w: amount %q is not in %s", ErrValidation, s, t.Symbol)
+		}
+		s = fields[0]
+	}
+	s = strings.ReplaceAll(s, ",", "")
+
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" {
+		// Blank input or a lone sign or point is not zero
+		return Balance{}, fmt.Errorf("%w: amount %q has no digits", ErrValidation, s)
+	}
+	if len(frac) > t.Decimals {
+		return Balance{}, fmt.Errorf("%w: amount %q has more than %d decimals", ErrValidation, s, t.Decimals)
+	}
+	if whole == "" {
+		whole = "0"
+	}
+
+	digits := whole + frac + strings.Repeat("0", t.Decimals-len(frac))
+	n, ok := new(big.Int).SetString(digits, 10)
+	if !ok || strings.ContainsAny(digits, "+-") {
+		return Balance{}, fmt.Errorf("%w: invalid amount %q", ErrValidation, s)
+	}
+	if neg {
+		n.Neg(n)
+	}
+	return Balance{v: n}, nil
+}
+
+// ParseBalance converts an amount with a known token symbol, such as
+// "12.5 DOT" or "0.3 KSM", to planck and returns the token it was given in
+func ParseBalance(s string) (Balance, Token, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Balance{}, Token{}, fmt.Errorf("%w: amount %q needs a token symbol", ErrValidation, s)
+	}
+	for _, t := range networkTokens {
+		if strings.EqualFold(t.Symbol, fields[1]) {
+			b, err := t.Parse(s)
+			return b, t, err
+		}
+	}
+	return Balance{}, Token{}, fmt.Errorf("%w: unknown token %q", ErrValidation, fields[1])
+}
+
+// MarshalJSON encodes b as a decimal string
+func (b Balance) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON accepts numbers, decimal strings with optional thousands
+// separators, hex strings ("0x...") and null or "" for zero
+func (b *Balance) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*b = Balance{}
+		return nil
+	}
+
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+
+	n, ok := parsePlanck(s)
+	if !ok {
+		return fmt.Errorf("invalid balance %s", data)
+	}
+	*b = Balance{v: n}
+	return nil
+}
+
+// parsePlanck parses hex ("0x...") or decimal planck amounts, optionally
+// containing thousands separators
+func parsePlanck(s string) (*big.Int, bool) {
+	s = strings.TrimSpace(strings.ReplaceAll(s, ",", ""))
+	if s == "" {
+		return new(big.Int), true
+	}
+
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return new(big.Int).SetString(s[2:], 16)
+	}
+	if n, ok := new(big.Int).SetString(s, 10); ok {
+		return n, true
+	}
+
+	// Large JSON numbers are sometimes written in exponent form
+	f, ok := new(big.Float).SetPrec(256).SetString(s)
+	if !ok || !f.IsInt() {
+		return nil, false
+	}
+	n, _ := f.Int(nil)
+	return n, true
+}
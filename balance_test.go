@@ -0,0 +1,112 @@
+package polkassembly
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestBalanceUnmarshal(t *testing.T) {
+	cases := map[string]string{
+		`"123456789012345678901234567890"`: "123456789012345678901234567890",
+		`"0x1bc16d674ec80000"`:             "2000000000000000000",
+		`"1,000,000"`:                      "1000000",
+		`42`:                               "42",
+		`1e21`:                             "1000000000000000000000",
+		`""`:                               "0",
+		`null`:                             "0",
+	}
+	for in, want := range cases {
+		var b Balance
+		if err := json.Unmarshal([]byte(in), &b); err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+		if b.String() != want {
+			t.Errorf("%s: got %s, want %s", in, b, want)
+		}
+	}
+
+	var b Balance
+	if err := json.Unmarshal([]byte(`"12abc"`), &b); err == nil {
+		t.Error("expected error for invalid balance")
+	}
+}
+
+func TestBalanceMarshalRoundTrip(t *testing.T) {
+	in := CartAmount{Aye: NewBalanceInt64(5_000_000_000)}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"abstain":"0","aye":"5000000000","nay":"0"}` {
+		t.Errorf("unexpected JSON %s", data)
+	}
+
+	var out CartAmount
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Aye.Cmp(in.Aye) != 0 {
+		t.Errorf("round trip changed aye: %s", out.Aye)
+	}
+}
+
+func TestBalanceFormatAndParse(t *testing.T) {
+	dot, _ := NetworkToken("polkadot")
+	ksm, _ := NetworkToken("kusama")
+
+	cases := []struct {
+		planck int64
+		token  Token
+		want   string
+	}{
+		{125_000_000_000, dot, "12.5 DOT"},
+		{10_000_000_000, dot, "1 DOT"},
+		{1, dot, "0.0000000001 DOT"},
+		{0, dot, "0 DOT"},
+		{-5_000_000_000, dot, "-0.5 DOT"},
+		{300_000_000_000, ksm, "0.3 KSM"},
+		{42, Token{}, "42"},
+	}
+	for _, tc := range cases {
+		b := NewBalanceInt64(tc.planck)
+		if got := b.Format(tc.token); got != tc.want {
+			t.Errorf("Format(%d): got %q, want %q", tc.planck, got, tc.want)
+		}
+		parsed, err := tc.token.Parse(tc.want)
+		if err != nil || parsed.Cmp(b) != 0 {
+			t.Errorf("Parse(%q): got %s, %v", tc.want, parsed, err)
+		}
+	}
+
+	b, token, err := ParseBalance("12.5 dot")
+	if err != nil || token != dot || b.String() != "125000000000" {
+		t.Errorf("ParseBalance: got %s %v %v", b, token, err)
+	}
+
+	for _, bad := range []string{"1.5 KSM", "0.00000000001", "1.2.3", "abc", "", "   ", "-", ".", "- DOT"} {
+		if _, err := dot.Parse(bad); !errors.Is(err, ErrValidation) {
+			t.Errorf("Parse(%q): expected ErrValidation, got %v", bad, err)
+		}
+	}
+	if _, _, err := ParseBalance("5 XYZ"); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation for unknown token, got %v", err)
+	}
+}
+
+func TestTreasuryValueSumsBeneficiaries(t *testing.T) {
+	var post Post
+	err := json.Unmarshal([]byte(`{"index":7,"onChainInfo":{"beneficiaries":[
+		{"address":"a","amount":"0x3b9aca00","assetId":null},
+		{"address":"b","amount":"1,000","assetId":"native"},
+		{"address":"c","amount":"5","assetId":"1984"}]}}`), &post)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := treasuryProposalFromPost(post)
+	if p.Value.String() != "1000001000" || p.Beneficiaries[2].Amount.String() != "5" {
+		t.Errorf("unexpected value %s, beneficiaries %+v", p.Value, p.Beneficiaries)
+	}
+}
@@ -1,12 +1,14 @@
 package polkassembly
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"net/http/cookiejar"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -18,14 +20,26 @@ type TokenStorage interface {
 	DeleteToken() error
 }
 
+// Client is safe for concurrent use. Credentials and network live on the
+// Client rather than the shared transport, so derived clients created with
+// WithToken and WithNetwork can serve different users side by side.
 type Client struct {
-	client       *resty.Client
-	baseURL      string
-	token        string
-	network      string
-	tokenStorage TokenStorage
-	debug        bool
-	logger       *log.Logger
+	client        *resty.Client
+	customBaseURL bool
+	tokenStorage  TokenStorage
+	debug         bool
+	logger        *log.Logger
+
+	mu      sync.RWMutex
+	baseURL string
+	token   string
+	network string
+	cookies []*http.Cookie
+
+	reauth        ReauthFunc
+	refreshBefore time.Duration
+	// authMu serializes re-authentication
+	authMu sync.Mutex
 }
 
 type Config struct {
@@ -36,11 +50,53 @@ type Config struct {
 	TokenStorage TokenStorage
 	Debug        bool
 	Logger       *log.Logger
+	// Retry configures automatic retries. Nil uses DefaultRetryPolicy.
+	Retry *RetryPolicy
+	// RateLimiter throttles every request. Share one limiter between
+	// clients to give them a common budget.
+	RateLimiter RateLimiter
+	// WriteRateLimiter throttles POST/PATCH/PUT/DELETE requests instead of
+	// RateLimiter when set.
+	WriteRateLimiter RateLimiter
+	// Reauth obtains a new token when the current one is about to expire or
+	// is rejected, after which the request is replayed once.
+	// AuthenticateWithSigner sets it to sign in again with the same signer.
+	Reauth ReauthFunc
+	// RefreshBefore is how long before expiry a token is refreshed. Zero
+	// uses DefaultRefreshBefore; a negative value only re-authenticates
+	// after a 401.
+	RefreshBefore time.Duration
 }
 
+// NewClient creates a client for cfg. Saving Config.Token to, or loading the
+// token from, Config.TokenStorage is best-effort: a failure is only logged in
+// debug mode and the client starts with whatever token it has. Use OpenClient
+// to get that error.
 func NewClient(cfg Config) *Client {
-	if cfg.BaseURL == "" {
-		cfg.BaseURL = fmt.Sprintf("https://%s.polkassembly.io/api/v2", cfg.Network)
+	c, err := newClient(cfg)
+	if err != nil {
+		c.logDebug("%v", err)
+	}
+	return c
+}
+
+// OpenClient is like NewClient but returns the error of saving Config.Token
+// to, or loading the token from, Config.TokenStorage, e.g. a wrong
+// passphrase for EncryptedTokenStorage
+func OpenClient(cfg Config) (*Client, error) {
+	c, err := newClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// newClient builds the client and returns it together with any TokenStorage
+// error; the client is usable either way
+func newClient(cfg Config) (*Client, error) {
+	customBaseURL := cfg.BaseURL != ""
+	if !customBaseURL {
+		cfg.BaseURL = defaultBaseURL(cfg.Network)
 	}
 
 	if cfg.Timeout == 0 {
@@ -61,30 +117,54 @@ func NewClient(cfg Config) *Client {
 		SetBaseURL(cfg.BaseURL).
 		SetTimeout(cfg.Timeout).
 		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("x-network", cfg.Network)
+		SetHeader("Accept", "application/json")
 
 	client.SetCookieJar(nil)
+	client.SetTransport(&reauthTransport{base: httpClient.Transport})
+
+	if cfg.RefreshBefore == 0 {
+		cfg.RefreshBefore = DefaultRefreshBefore
+	}
+
+	if cfg.Retry == nil {
+		cfg.Retry = DefaultRetryPolicy()
+	}
 
 	c := &Client{
-		client:       client,
-		baseURL:      cfg.BaseURL,
-		network:      cfg.Network,
-		token:        cfg.Token,
-		tokenStorage: cfg.TokenStorage,
-		debug:        cfg.Debug,
-		logger:       cfg.Logger,
+		client:        client,
+		customBaseURL: customBaseURL,
+		baseURL:       cfg.BaseURL,
+		network:       cfg.Network,
+		token:         cfg.Token,
+		tokenStorage:  cfg.TokenStorage,
+		debug:         cfg.Debug,
+		logger:        cfg.Logger,
+		reauth:        cfg.Reauth,
+		refreshBefore: cfg.RefreshBefore,
 	}
 
+	client.SetLogger(restyLogger{c})
+	client.OnBeforeRequest(applyRequestBaseURL)
+	installRateLimiters(client, cfg.RateLimiter, cfg.WriteRateLimiter)
+	cfg.Retry.apply(client, c.logDebug)
+
 	if cfg.Token != "" {
-		c.SetAuthToken(cfg.Token)
+		if err := c.SetAuthToken(cfg.Token); err != nil {
+			return c, err
+		}
 	} else if cfg.TokenStorage != nil {
-		if token, err := cfg.TokenStorage.GetToken(); err == nil && token != "" {
-			c.SetAuthToken(token)
+		token, err := cfg.TokenStorage.GetToken()
+		if err != nil {
+			return c, fmt.Errorf("load token: %w", err)
+		}
+		if token != "" {
+			c.mu.Lock()
+			c.token = token
+			c.mu.Unlock()
 		}
 	}
 
-	return c
+	return c, nil
 }
 
 func (c *Client) logDebug(format string, v ...interface{}) {
@@ -93,21 +173,160 @@ func (c *Client) logDebug(format string, v ...interface{}) {
 	}
 }
 
-func (c *Client) SetAuthToken(token string) {
-	c.token = token
+// restyLogger routes resty's internal warnings (e.g. retry attempts) to the
+// client's debug logger instead of stderr.
+type restyLogger struct {
+	c *Client
+}
+
+func (l restyLogger) Errorf(format string, v ...interface{}) { l.c.logDebug(format, v...) }
+func (l restyLogger) Warnf(format string, v ...interface{})  { l.c.logDebug(format, v...) }
+func (l restyLogger) Debugf(format string, v ...interface{}) { l.c.logDebug(format, v...) }
+
+func defaultBaseURL(network string) string {
+	return fmt.Sprintf("https://%s.polkassembly.io/api/v2", network)
+}
+
+type baseURLKey struct{}
+
+// newRequest returns a request bound to ctx. Every endpoint builds its
+// request through here so cancellation reaches the underlying transport
+// and the client's own credentials, network and cookies are attached per
+// request instead of being shared through the resty client.
+func (c *Client) newRequest(ctx context.Context) *resty.Request {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	c.mu.RLock()
+	baseURL, token, network := c.baseURL, c.token, c.network
+	cookies := c.cookies
+	c.mu.RUnlock()
+
+	ctx = context.WithValue(ctx, baseURLKey{}, baseURL)
+	ctx = context.WithValue(ctx, requestAuthKey{}, requestAuth{client: c, token: token})
+	r := c.client.R().
+		SetContext(ctx).
+		SetHeader("x-network", network)
+	if token != "" {
+		r.SetHeader("Authorization", authorizationHeader(token))
+	}
+	if len(cookies) > 0 {
+		r.SetCookies(cookies)
+	}
+	return r
+}
+
+// applyRequestBaseURL resolves relative paths against the base URL of the
+// Client that built the request, so derived clients on other networks can
+// share one transport.
+func applyRequestBaseURL(_ *resty.Client, r *resty.Request) error {
+	base, ok := r.Context().Value(baseURLKey{}).(string)
+	if !ok || base == "" || strings.Contains(r.URL, "://") {
+		return nil
+	}
+	r.URL = strings.TrimRight(base, "/") + "/" + strings.TrimLeft(r.URL, "/")
+	return nil
+}
+
+func authorizationHeader(token string) string {
 	if strings.Count(token, ".") >= 2 {
-		c.client.SetHeader("Authorization", "Bearer "+token)
-	} else {
-		c.client.SetHeader("Authorization", token)
+		return "Bearer " + token
 	}
-	if c.tokenStorage != nil {
-		c.tokenStorage.SaveToken(token)
+	return token
+}
+
+// SetAuthToken sets the token sent with every request and saves it to the
+// TokenStorage, if any. The token is used even when saving fails.
+func (c *Client) SetAuthToken(token string) error {
+	c.mu.Lock()
+	c.token = token
+	storage := c.tokenStorage
+	c.mu.Unlock()
+	if storage != nil {
+		if err := storage.SaveToken(token); err != nil {
+			return fmt.Errorf("save token: %w", err)
+		}
 	}
+	return nil
 }
 
 func (c *Client) SetNetwork(network string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.network = network
-	c.client.SetHeader("x-network", network)
+	if !c.customBaseURL {
+		c.baseURL = defaultBaseURL(network)
+	}
+}
+
+// WithNetwork returns a client for another network that shares this
+// client's connection pool, retry policy and rate limiters.
+func (c *Client) WithNetwork(network string) *Client {
+	d := c.derive()
+	d.network = network
+	if !d.customBaseURL {
+		d.baseURL = defaultBaseURL(network)
+	}
+	return d
+}
+
+// WithToken returns a client that authenticates with token and shares this
+// client's connection pool. The token is not written to TokenStorage.
+func (c *Client) WithToken(token string) *Client {
+	d := c.derive()
+	d.token = token
+	d.cookies = nil
+	d.tokenStorage = nil
+	d.reauth = nil
+	return d
+}
+
+func (c *Client) derive() *Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return &Client{
+		client:        c.client,
+		customBaseURL: c.customBaseURL,
+		tokenStorage:  c.tokenStorage,
+		debug:         c.debug,
+		logger:        c.logger,
+		baseURL:       c.baseURL,
+		token:         c.token,
+		network:       c.network,
+		cookies:       append([]*http.Cookie(nil), c.cookies...),
+		reauth:        c.reauth,
+		refreshBefore: c.refreshBefore,
+	}
+}
+
+// Network returns the network requests are sent to
+func (c *Client) Network() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.network
+}
+
+// setCookie stores a session cookie on this client only. The slice is
+// replaced rather than modified since in-flight requests may hold it.
+func (c *Client) setCookie(cookie *http.Cookie) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cookies := make([]*http.Cookie, 0, len(c.cookies)+1)
+	for _, existing := range c.cookies {
+		if existing.Name != cookie.Name {
+			cookies = append(cookies, existing)
+		}
+	}
+	c.cookies = append(cookies, cookie)
+}
+
+// checkResponse returns an *APIError for non-2xx responses
+func (c *Client) checkResponse(resp *resty.Response) error {
+	if resp.IsError() {
+		return newAPIError(resp)
+	}
+	return nil
 }
 
 func (c *Client) parseResponse(resp *resty.Response, v interface{}) error {
@@ -115,12 +334,8 @@ func (c *Client) parseResponse(resp *resty.Response, v interface{}) error {
 		c.logDebug("Error response: %d - %s", resp.StatusCode(), string(resp.Body()))
 	}
 
-	if resp.IsError() {
-		var apiErr APIError
-		if err := json.Unmarshal(resp.Body(), &apiErr); err != nil {
-			return fmt.Errorf("HTTP %d: %s", resp.StatusCode(), string(resp.Body()))
-		}
-		return &apiErr
+	if err := c.checkResponse(resp); err != nil {
+		return err
 	}
 
 	if v != nil && len(resp.Body()) > 0 {
@@ -141,9 +356,9 @@ func (c *Client) parseResponse(resp *resty.Response, v interface{}) error {
 	return nil
 }
 
-func (c *Client) handleAuthResponse(token string) {
+func (c *Client) handleAuthResponse(token string) error {
 	if token != "" {
-		c.SetAuthToken(token)
+		return c.SetAuthToken(token)
 	}
-	c.client.SetCookieJar(nil)
+	return nil
 }
@@ -0,0 +1,73 @@
+package polkassembly
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// Run with -race to verify derived clients do not share mutable state
+func TestDerivedClientsConcurrentUse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(User{
+			Username: r.Header.Get("Authorization"),
+			Title:    r.Header.Get("x-network"),
+		})
+	}))
+	defer srv.Close()
+
+	base := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			token := fmt.Sprintf("token-%d", i)
+			network := "polkadot"
+			if i%2 == 0 {
+				network = "kusama"
+			}
+
+			c := base.WithNetwork(network).WithToken(token)
+			for j := 0; j < 5; j++ {
+				user, err := c.GetUserByID(i)
+				if err != nil {
+					t.Errorf("GetUserByID failed: %v", err)
+					return
+				}
+				if user.Username != token || user.Title != network {
+					t.Errorf("request %d leaked credentials: got %q/%q", i, user.Username, user.Title)
+				}
+			}
+		}(i)
+	}
+
+	// Mutating the parent concurrently must not affect derived clients
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			base.SetAuthToken(fmt.Sprintf("parent-%d", i))
+			base.SetNetwork("westend")
+		}
+	}()
+
+	wg.Wait()
+}
+
+func TestWithNetworkDefaultBaseURL(t *testing.T) {
+	c := NewClient(Config{Network: "polkadot"})
+	k := c.WithNetwork("kusama")
+
+	if k.baseURL != "https://kusama.polkassembly.io/api/v2" {
+		t.Errorf("unexpected base URL %s", k.baseURL)
+	}
+	if c.Network() != "polkadot" || k.Network() != "kusama" {
+		t.Errorf("derived client changed parent network")
+	}
+}
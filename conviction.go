@@ -0,0 +1,140 @@
+package polkassembly
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Conviction is the OpenGov lock multiplier chosen for a vote. It is the
+// lockPeriod of a vote: 0 is no lock (0.1x) and 1 to 6 lock the balance for
+// 1 to 32 voting periods in exchange for a 1x to 6x multiplier.
+type Conviction int
+
+const (
+	ConvictionNone Conviction = iota
+	ConvictionLocked1x
+	ConvictionLocked2x
+	ConvictionLocked3x
+	ConvictionLocked4x
+	ConvictionLocked5x
+	ConvictionLocked6x
+)
+
+// voteLockingPeriods is the base lock of each network's conviction voting
+// pallet, doubled for every conviction level above 1x
+var voteLockingPeriods = map[string]time.Duration{
+	"polkadot": 28 * 24 * time.Hour,
+	"kusama":   7 * 24 * time.Hour,
+	"westend":  7 * 24 * time.Hour,
+	"paseo":    28 * 24 * time.Hour,
+}
+
+func (c Conviction) Valid() bool { return c >= ConvictionNone && c <= ConvictionLocked6x }
+
+// multiplier returns the vote multiplier in tenths, so 0.1x is 1 and 6x is 60
+func (c Conviction) multiplier() int64 {
+	if c <= ConvictionNone {
+		return 1
+	}
+	return int64(min(c, ConvictionLocked6x)) * 10
+}
+
+// Multiplier returns the vote multiplier, from 0.1 to 6
+func (c Conviction) Multiplier() float64 { return float64(c.multiplier()) / 10 }
+
+// LockPeriods returns how many voting periods the balance stays locked
+func (c Conviction) LockPeriods() int {
+	if c <= ConvictionNone {
+		return 0
+	}
+	return 1 << (min(c, ConvictionLocked6x) - 1)
+}
+
+// LockDuration returns how long a balance voted with c stays locked on a
+// network after the referendum ends
+func (c Conviction) LockDuration(network string) (time.Duration, error) {
+	period, ok := voteLockingPeriods[strings.ToLower(network)]
+	if !ok {
+		return 0, fmt.Errorf("%w: no vote locking period for network %q", ErrValidation, network)
+	}
+	return time.Duration(c.LockPeriods()) * period, nil
+}
+
+// Votes returns the effective votes of balance at conviction c
+func (c Conviction) Votes(balance Balance) Balance {
+	n := balance.Int()
+	n.Mul(n, big.NewInt(c.multiplier()))
+	n.Quo(n, big.NewInt(10))
+	return Balance{v: n}
+}
+
+func (c Conviction) String() string {
+	if c <= ConvictionNone {
+		return "0.1x"
+	}
+	return fmt.Sprintf("%dx", min(c, ConvictionLocked6x))
+}
+
+// Tally is the outcome of a set of votes as computed by the conviction
+// voting pallet. Ayes and Nays include conviction; Support is the aye and
+// abstain balance without conviction and BareAyes the aye balance alone.
+type Tally struct {
+	Ayes     Balance
+	Nays     Balance
+	Support  Balance
+	BareAyes Balance
+}
+
+// TallyVotes recomputes the tally of a referendum from its votes, e.g. those
+// returned by AllVotes, to cross-check the post's VoteMetrics
+func TallyVotes(votes []Vote) Tally {
+	var t Tally
+	for _, v := range votes {
+		decision := strings.ToLower(v.Decision)
+		if decision == "" {
+			decision = strings.ToLower(v.Vote)
+		}
+
+		switch decision {
+		case "aye", "yes":
+			t.Ayes = t.Ayes.Add(v.LockPeriod.Votes(v.Balance))
+			t.BareAyes = t.BareAyes.Add(v.Balance)
+			t.Support = t.Support.Add(v.Balance)
+		case "nay", "no":
+			t.Nays = t.Nays.Add(v.LockPeriod.Votes(v.Balance))
+		case "abstain", "splitabstain":
+			// Abstain votes carry no conviction and count towards support only
+			t.Support = t.Support.Add(v.Balance)
+		}
+	}
+	return t
+}
+
+// TallyFromMetrics converts the vote metrics of a post to a Tally
+func TallyFromMetrics(m VoteMetrics) Tally {
+	return Tally{
+		Ayes:     m.Aye.Value,
+		Nays:     m.Nay.Value,
+		Support:  m.Support.Value,
+		BareAyes: m.BareAyes.Value,
+	}
+}
+
+// Approval returns the share of conviction-weighted votes in favour, from 0
+// to 1. It is 0 when nobody has voted.
+func (t Tally) Approval() float64 {
+	total := t.Ayes.Add(t.Nays)
+	if total.IsZero() {
+		return 0
+	}
+	r, _ := new(big.Rat).SetFrac(t.Ayes.Int(), total.Int()).Float64()
+	return r
+}
+
+// Equal reports whether both tallies have the same amounts
+func (t Tally) Equal(o Tally) bool {
+	return t.Ayes.Cmp(o.Ayes) == 0 && t.Nays.Cmp(o.Nays) == 0 &&
+		t.Support.Cmp(o.Support) == 0 && t.BareAyes.Cmp(o.BareAyes) == 0
+}
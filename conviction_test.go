@@ -0,0 +1,82 @@
+package polkassembly
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestConvictionMultipliersAndLocks(t *testing.T) {
+	const day = 24 * time.Hour
+	cases := []struct {
+		c       Conviction
+		mult    float64
+		periods int
+		dot     time.Duration
+	}{
+		{ConvictionNone, 0.1, 0, 0},
+		{ConvictionLocked1x, 1, 1, 28 * day},
+		{ConvictionLocked2x, 2, 2, 56 * day},
+		{ConvictionLocked3x, 3, 4, 112 * day},
+		{ConvictionLocked4x, 4, 8, 224 * day},
+		{ConvictionLocked5x, 5, 16, 448 * day},
+		{ConvictionLocked6x, 6, 32, 896 * day},
+	}
+	for _, tc := range cases {
+		if tc.c.Multiplier() != tc.mult || tc.c.LockPeriods() != tc.periods {
+			t.Errorf("%s: got %v, %d periods", tc.c, tc.c.Multiplier(), tc.c.LockPeriods())
+		}
+		if d, err := tc.c.LockDuration("polkadot"); err != nil || d != tc.dot {
+			t.Errorf("%s on polkadot: got %v, %v", tc.c, d, err)
+		}
+	}
+
+	if d, _ := ConvictionLocked1x.LockDuration("kusama"); d != 7*day {
+		t.Errorf("1x on kusama: got %v", d)
+	}
+	if _, err := ConvictionLocked1x.LockDuration("moonbeam"); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation for unknown network, got %v", err)
+	}
+
+	if got := ConvictionNone.Votes(NewBalanceInt64(15)).String(); got != "1" {
+		t.Errorf("0.1x of 15: got %s", got)
+	}
+	if got := ConvictionLocked6x.Votes(NewBalanceInt64(15)).String(); got != "90" {
+		t.Errorf("6x of 15: got %s", got)
+	}
+}
+
+func TestTallyVotes(t *testing.T) {
+	var votes []Vote
+	err := json.Unmarshal([]byte(`[
+		{"voter":"a","decision":"aye","balance":"100","lockPeriod":0},
+		{"voter":"b","decision":"aye","balance":"0x64","lockPeriod":3},
+		{"voter":"c","vote":"nay","balance":"50","lockPeriod":6},
+		{"voter":"d","decision":"abstain","balance":"20"}
+	]`), &votes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := TallyVotes(votes)
+	want := Tally{
+		Ayes:     NewBalanceInt64(10 + 300),
+		Nays:     NewBalanceInt64(300),
+		Support:  NewBalanceInt64(220),
+		BareAyes: NewBalanceInt64(200),
+	}
+	if !got.Equal(want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if a := got.Approval(); a < 0.508 || a > 0.509 {
+		t.Errorf("approval: got %v", a)
+	}
+
+	var metrics VoteMetrics
+	json.Unmarshal([]byte(`{"aye":{"count":2,"value":"310"},"nay":{"count":1,"value":"300"},
+		"support":{"value":"220"},"bareAyes":{"value":"200"}}`), &metrics)
+	if !TallyFromMetrics(metrics).Equal(got) {
+		t.Errorf("metrics tally differs: %+v", TallyFromMetrics(metrics))
+	}
+}
@@ -1,9 +1,16 @@
 package polkassembly
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 func (c *Client) GetDelegationStats() (*DelegationStats, error) {
-	r, err := c.client.R().
+	return c.GetDelegationStatsCtx(context.Background())
+}
+
+func (c *Client) GetDelegationStatsCtx(ctx context.Context) (*DelegationStats, error) {
+	r, err := c.newRequest(ctx).
 		Get("/delegation/stats")
 	if err != nil {
 		return nil, err
@@ -16,6 +23,10 @@ func (c *Client) GetDelegationStats() (*DelegationStats, error) {
 }
 
 func (c *Client) GetDelegates(page, limit int) ([]Delegate, error) {
+	return c.GetDelegatesCtx(context.Background(), page, limit)
+}
+
+func (c *Client) GetDelegatesCtx(ctx context.Context, page, limit int) ([]Delegate, error) {
 	queryParams := make(map[string]string)
 	if page > 0 {
 		queryParams["page"] = fmt.Sprintf("%d", page)
@@ -23,7 +34,7 @@ func (c *Client) GetDelegates(page, limit int) ([]Delegate, error) {
 	if limit > 0 {
 		queryParams["limit"] = fmt.Sprintf("%d", limit)
 	}
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetQueryParams(queryParams).
 		Get("/delegation/delegates")
 	if err != nil {
@@ -37,7 +48,11 @@ func (c *Client) GetDelegates(page, limit int) ([]Delegate, error) {
 }
 
 func (c *Client) CreatePADelegate(req CreatePADelegateRequest) (*Delegate, error) {
-	r, err := c.client.R().
+	return c.CreatePADelegateCtx(context.Background(), req)
+}
+
+func (c *Client) CreatePADelegateCtx(ctx context.Context, req CreatePADelegateRequest) (*Delegate, error) {
+	r, err := c.newRequest(ctx).
 		SetBody(req).
 		Post("/delegation/delegates")
 	if err != nil {
@@ -51,7 +66,11 @@ func (c *Client) CreatePADelegate(req CreatePADelegateRequest) (*Delegate, error
 }
 
 func (c *Client) UpdatePADelegate(address string, manifesto string) (*Delegate, error) {
-	r, err := c.client.R().
+	return c.UpdatePADelegateCtx(context.Background(), address, manifesto)
+}
+
+func (c *Client) UpdatePADelegateCtx(ctx context.Context, address string, manifesto string) (*Delegate, error) {
+	r, err := c.newRequest(ctx).
 		SetBody(map[string]string{"manifesto": manifesto}).
 		Patch(fmt.Sprintf("/delegation/delegates/%s", address))
 	if err != nil {
@@ -65,7 +84,11 @@ func (c *Client) UpdatePADelegate(address string, manifesto string) (*Delegate,
 }
 
 func (c *Client) GetPADelegate(address string) (*Delegate, error) {
-	r, err := c.client.R().
+	return c.GetPADelegateCtx(context.Background(), address)
+}
+
+func (c *Client) GetPADelegateCtx(ctx context.Context, address string) (*Delegate, error) {
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/delegation/delegates/%s", address))
 	if err != nil {
 		return nil, err
@@ -78,7 +101,11 @@ func (c *Client) GetPADelegate(address string) (*Delegate, error) {
 }
 
 func (c *Client) DeletePADelegate(address string) error {
-	r, err := c.client.R().
+	return c.DeletePADelegateCtx(context.Background(), address)
+}
+
+func (c *Client) DeletePADelegateCtx(ctx context.Context, address string) error {
+	r, err := c.newRequest(ctx).
 		Delete(fmt.Sprintf("/delegation/delegates/%s", address))
 	if err != nil {
 		return err
@@ -87,7 +114,11 @@ func (c *Client) DeletePADelegate(address string) error {
 }
 
 func (c *Client) GetUserAllTracksStats(address string) ([]TrackStats, error) {
-	r, err := c.client.R().
+	return c.GetUserAllTracksStatsCtx(context.Background(), address)
+}
+
+func (c *Client) GetUserAllTracksStatsCtx(ctx context.Context, address string) ([]TrackStats, error) {
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/users/address/%s/delegation/tracks", address))
 	if err != nil {
 		return nil, err
@@ -100,7 +131,11 @@ func (c *Client) GetUserAllTracksStats(address string) ([]TrackStats, error) {
 }
 
 func (c *Client) GetUserTracksLevelData(address string, trackNum int) ([]TrackLevelData, error) {
-	r, err := c.client.R().
+	return c.GetUserTracksLevelDataCtx(context.Background(), address, trackNum)
+}
+
+func (c *Client) GetUserTracksLevelDataCtx(ctx context.Context, address string, trackNum int) ([]TrackLevelData, error) {
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/users/address/%s/delegation/tracks/%d", address, trackNum))
 	if err != nil {
 		return nil, err
@@ -0,0 +1,217 @@
+package polkassembly
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// GetDiscussions retrieves off-chain discussions filtered by topic and tags
+func (c *Client) GetDiscussions(params DiscussionListingParams) (*DiscussionListingResponse, error) {
+	return c.GetDiscussionsCtx(context.Background(), params)
+}
+
+// GetDiscussionsCtx is like GetDiscussions but carries ctx for cancellation and deadlines
+func (c *Client) GetDiscussionsCtx(ctx context.Context, params DiscussionListingParams) (*DiscussionListingResponse, error) {
+	queryParams := make(map[string]string)
+	if params.Page > 0 {
+		queryParams["page"] = fmt.Sprintf("%d", params.Page)
+	}
+	if params.Limit > 0 {
+		queryParams["limit"] = fmt.Sprintf("%d", params.Limit)
+	}
+	if params.TopicID > 0 {
+		queryParams["topicId"] = fmt.Sprintf("%d", params.TopicID)
+	}
+	if len(params.Tags) > 0 {
+		queryParams["tags"] = strings.Join(params.Tags, ",")
+	}
+	if params.SortBy != "" {
+		queryParams["sortBy"] = params.SortBy
+	}
+
+	r, err := c.newRequest(ctx).
+		SetQueryParams(queryParams).
+		Get("/Discussion")
+	if err != nil {
+		return nil, err
+	}
+
+	var listing PostListingResponse
+	if err := c.parseResponse(r, &listing); err != nil {
+		return nil, err
+	}
+
+	resp := &DiscussionListingResponse{TotalCount: listing.TotalCount}
+	for _, post := range listing.Items {
+		d := discussionFromPost(post)
+		if d.TopicID == 0 {
+			d.TopicID = params.TopicID
+		}
+		resp.Discussions = append(resp.Discussions, d)
+	}
+
+	return resp, nil
+}
+
+// GetDiscussion retrieves a single discussion
+func (c *Client) GetDiscussion(discussionID int) (*Discussion, error) {
+	return c.GetDiscussionCtx(context.Background(), discussionID)
+}
+
+// GetDiscussionCtx is like GetDiscussion but carries ctx for cancellation and deadlines
+func (c *Client) GetDiscussionCtx(ctx context.Context, discussionID int) (*Discussion, error) {
+	post, err := c.GetPostByTypeCtx(ctx, discussionID, ProposalTypeDiscussion)
+	if err != nil {
+		return nil, err
+	}
+
+	d := discussionFromPost(*post)
+	return &d, nil
+}
+
+// CreateDiscussion creates an off-chain discussion with its topic and tags
+func (c *Client) CreateDiscussion(req CreateDiscussionRequest) (*Discussion, error) {
+	return c.CreateDiscussionCtx(context.Background(), req)
+}
+
+// CreateDiscussionCtx is like CreateDiscussion but carries ctx for cancellation and deadlines
+func (c *Client) CreateDiscussionCtx(ctx context.Context, req CreateDiscussionRequest) (*Discussion, error) {
+	if strings.TrimSpace(req.Title) == "" {
+		return nil, fmt.Errorf("%w: discussion title is required", ErrValidation)
+	}
+
+	post, err := c.CreateOffchainPostCtx(ctx, ProposalTypeDiscussion, CreateOffchainPostRequest{
+		Title:   req.Title,
+		Content: req.Content,
+		TopicID: req.TopicID,
+		Tags:    req.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d := discussionFromPost(*post)
+	if d.Title == "" {
+		d.Title = req.Title
+	}
+	if d.Tags == nil {
+		d.Tags = req.Tags
+	}
+	if d.TopicID == 0 {
+		d.TopicID = req.TopicID
+	}
+	return &d, nil
+}
+
+// UpdateDiscussionTags replaces the tags of a discussion. An empty slice
+// removes all tags.
+func (c *Client) UpdateDiscussionTags(discussionID int, tags []string) (*Discussion, error) {
+	return c.UpdateDiscussionTagsCtx(context.Background(), discussionID, tags)
+}
+
+// UpdateDiscussionTagsCtx is like UpdateDiscussionTags but carries ctx for cancellation and deadlines
+func (c *Client) UpdateDiscussionTagsCtx(ctx context.Context, discussionID int, tags []string) (*Discussion, error) {
+	if tags == nil {
+		tags = []string{}
+	}
+
+	post, err := c.UpdatePostCtx(ctx, ProposalTypeDiscussion, discussionID, UpdatePostRequest{Tags: tags})
+	if err != nil {
+		return nil, err
+	}
+
+	d := discussionFromPost(*post)
+	if d.ID == 0 {
+		d.ID = discussionID
+	}
+	if d.Tags == nil {
+		d.Tags = tags
+	}
+	return &d, nil
+}
+
+// ConvertDiscussionToProposal links a discussion to the on-chain proposal
+// it turned into and returns the on-chain post. If the on-chain post still
+// has the default content, the discussion's title and content are copied.
+func (c *Client) ConvertDiscussionToProposal(discussionID int, proposalType ProposalType, proposalIndex int) (*Post, error) {
+	return c.ConvertDiscussionToProposalCtx(context.Background(), discussionID, proposalType, proposalIndex)
+}
+
+// ConvertDiscussionToProposalCtx is like ConvertDiscussionToProposal but carries ctx for cancellation and deadlines
+func (c *Client) ConvertDiscussionToProposalCtx(ctx context.Context, discussionID int, proposalType ProposalType, proposalIndex int) (*Post, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+	if proposalType.IsOffChain() {
+		return nil, fmt.Errorf("%w: target must be an on-chain proposal type", ErrValidation)
+	}
+
+	discussion, err := c.GetPostByTypeCtx(ctx, discussionID, ProposalTypeDiscussion)
+	if err != nil {
+		return nil, fmt.Errorf("load discussion: %w", err)
+	}
+
+	r, err := c.newRequest(ctx).
+		SetBody(map[string]interface{}{
+			"linkedPost": map[string]interface{}{
+				"proposalType": ProposalTypeDiscussion,
+				"indexOrHash":  fmt.Sprintf("%d", discussionID),
+			},
+		}).
+		Post(fmt.Sprintf("/%s/%d/linked-post", proposalType, proposalIndex))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.parseResponse(r, nil); err != nil {
+		return nil, err
+	}
+
+	post, err := c.GetPostByTypeCtx(ctx, proposalIndex, proposalType)
+	if err != nil {
+		return nil, err
+	}
+
+	if post.IsDefaultContent {
+		return c.UpdatePostCtx(ctx, proposalType, proposalIndex, UpdatePostRequest{
+			Title:   discussion.Title,
+			Content: discussion.Content,
+			Tags:    discussion.Tags,
+		})
+	}
+
+	return post, nil
+}
+
+func discussionFromPost(post Post) Discussion {
+	id := post.Index
+	if id == 0 {
+		id = post.PostID
+	}
+	author := post.Username
+	if author == "" && post.PublicUser != nil {
+		author = post.PublicUser.Username
+	}
+
+	d := Discussion{
+		ID:            id,
+		Title:         post.Title,
+		Content:       post.Content,
+		Author:        author,
+		Tags:          post.Tags,
+		TopicID:       post.TopicID,
+		ViewCount:     post.ViewsCount,
+		CommentCount:  post.Metrics.Comments,
+		ReactionCount: post.Metrics.Reactions.Like + post.Metrics.Reactions.Dislike,
+		CreatedAt:     post.CreatedAt,
+		UpdatedAt:     post.UpdatedAt,
+	}
+	if d.CommentCount == 0 {
+		d.CommentCount = post.CommentsCount
+	}
+	if d.ReactionCount == 0 {
+		d.ReactionCount = post.ReactionsCount
+	}
+	return d
+}
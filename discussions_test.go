@@ -0,0 +1,123 @@
+package polkassembly
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetDiscussionsTopicAndTags(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/Discussion" || q.Get("topicId") != "4" || q.Get("tags") != "defi,grants" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"totalCount":2,"items":[
+			{"index":1,"title":"A","tags":["defi"],"topicId":4,"metrics":{"comments":3,"reactions":{"like":2,"dislike":1}}},
+			{"index":2,"title":"B","publicUser":{"username":"bob"},"comments_count":5}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+	resp, err := c.GetDiscussions(DiscussionListingParams{TopicID: 4, Tags: []string{"defi", "grants"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.TotalCount != 2 || len(resp.Discussions) != 2 {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+	a, b := resp.Discussions[0], resp.Discussions[1]
+	if a.ID != 1 || a.TopicID != 4 || a.CommentCount != 3 || a.ReactionCount != 3 || a.Tags[0] != "defi" {
+		t.Errorf("first discussion: %+v", a)
+	}
+	if b.Author != "bob" || b.CommentCount != 5 || b.TopicID != 4 {
+		t.Errorf("second discussion: %+v", b)
+	}
+}
+
+func TestDiscussionTopicAndTagsOnWrite(t *testing.T) {
+	var bodies []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		w.Write([]byte(`{"index":9}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+
+	d, err := c.CreateDiscussion(CreateDiscussionRequest{Title: "Idea", Content: "Body", TopicID: 2, Tags: []string{"infra"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.ID != 9 || d.TopicID != 2 || d.Tags[0] != "infra" || d.Title != "Idea" {
+		t.Errorf("created discussion: %+v", d)
+	}
+
+	// UpdateDiscussionTags(nil) clears the tags; UpdatePost without Tags keeps them
+	if _, err := c.UpdateDiscussionTags(9, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.UpdatePost(ProposalTypeDiscussion, 9, UpdatePostRequest{Title: "Renamed", TopicID: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bodies) != 3 {
+		t.Fatalf("got %d requests", len(bodies))
+	}
+	if bodies[0]["topicId"] != float64(2) || bodies[0]["tags"].([]interface{})[0] != "infra" || bodies[0]["topic_id"] != nil {
+		t.Errorf("create body: %v", bodies[0])
+	}
+	if tags, ok := bodies[1]["tags"].([]interface{}); !ok || len(tags) != 0 {
+		t.Errorf("clear tags body: %v", bodies[1])
+	}
+	if _, ok := bodies[2]["tags"]; ok || bodies[2]["topicId"] != float64(3) || bodies[2]["title"] != "Renamed" {
+		t.Errorf("update body: %v", bodies[2])
+	}
+
+	if _, err := c.CreateDiscussion(CreateDiscussionRequest{Title: " "}); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation for empty title, got %v", err)
+	}
+	if _, err := c.CreateOffchainPost(ProposalTypeReferendumV2, CreateOffchainPostRequest{Title: "x"}); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation for on-chain type, got %v", err)
+	}
+}
+
+func TestConvertDiscussionToProposal(t *testing.T) {
+	var linked map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/Discussion/7":
+			w.Write([]byte(`{"index":7,"title":"Idea","content":"Details","tags":["infra"]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/ReferendumV2/120/linked-post":
+			json.NewDecoder(r.Body).Decode(&linked)
+			w.Write([]byte(`{}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/ReferendumV2/120":
+			w.Write([]byte(`{"index":120,"isDefaultContent":false,"title":"Ref 120"}`))
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+	post, err := c.ConvertDiscussionToProposal(7, ProposalTypeReferendumV2, 120)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if post.Index != 120 {
+		t.Errorf("got post %+v", post)
+	}
+	link, _ := linked["linkedPost"].(map[string]interface{})
+	if link["proposalType"] != string(ProposalTypeDiscussion) || link["indexOrHash"] != "7" {
+		t.Errorf("linked post body: %v", linked)
+	}
+
+	if _, err := c.ConvertDiscussionToProposal(7, ProposalTypeDiscussion, 120); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation for off-chain target, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package polkassembly
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Sentinel errors matched by APIError via errors.Is
+var (
+	ErrNotFound     = errors.New("polkassembly: not found")
+	ErrUnauthorized = errors.New("polkassembly: unauthorized")
+	ErrRateLimited  = errors.New("polkassembly: rate limited")
+	ErrValidation   = errors.New("polkassembly: validation failed")
+)
+
+// APIError is returned for every non-2xx response from the API
+type APIError struct {
+	ErrorMessage string `json:"error"`
+	Message      string `json:"message"`
+
+	StatusCode int    `json:"-"`
+	Method     string `json:"-"`
+	Endpoint   string `json:"-"`
+	RequestID  string `json:"-"`
+	Body       []byte `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = e.ErrorMessage
+	}
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	if e.Method == "" && e.Endpoint == "" {
+		return fmt.Sprintf("HTTP %d: %s", e.StatusCode, msg)
+	}
+	return fmt.Sprintf("%s %s: HTTP %d: %s", e.Method, e.Endpoint, e.StatusCode, msg)
+}
+
+// Is reports whether the error matches one of the package sentinels
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// newAPIError builds an APIError from an error response
+func newAPIError(resp *resty.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode(),
+		Body:       resp.Body(),
+		RequestID:  requestID(resp.Header()),
+	}
+	// Body may not be JSON (e.g. a gateway HTML page); keep it raw then
+	_ = json.Unmarshal(resp.Body(), apiErr)
+
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Endpoint = resp.Request.URL
+		if u, err := url.Parse(resp.Request.URL); err == nil && u.Path != "" {
+			apiErr.Endpoint = u.Path
+		}
+	}
+
+	return apiErr
+}
+
+func requestID(h http.Header) string {
+	for _, key := range []string{"X-Request-Id", "X-Correlation-Id", "Cf-Ray"} {
+		if v := h.Get(key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,67 @@
+package polkassembly
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIErrorSentinels(t *testing.T) {
+	cases := []struct {
+		status int
+		target error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrUnauthorized},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusBadRequest, ErrValidation},
+	}
+
+	for _, tc := range cases {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req-123")
+			w.WriteHeader(tc.status)
+			w.Write([]byte(`{"message":"nope"}`))
+		}))
+
+		c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot", Retry: &RetryPolicy{MaxAttempts: 1}})
+		_, err := c.GetPostByType(42, "ReferendumV2")
+		srv.Close()
+
+		if !errors.Is(err, tc.target) {
+			t.Errorf("status %d: expected %v, got %v", tc.status, tc.target, err)
+			continue
+		}
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("status %d: expected *APIError, got %T", tc.status, err)
+		}
+		if apiErr.StatusCode != tc.status || apiErr.Method != http.MethodGet ||
+			apiErr.Endpoint != "/ReferendumV2/42" || apiErr.RequestID != "req-123" ||
+			apiErr.Message != "nope" {
+			t.Errorf("status %d: unexpected error fields %+v", tc.status, apiErr)
+		}
+	}
+}
+
+func TestAPIErrorNonJSONBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("<html>not here</html>"))
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+	_, err := c.GetPostCommentsByType(1, "ReferendumV2")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found APIError, got %v", err)
+	}
+	if string(apiErr.Body) != "<html>not here</html>" {
+		t.Errorf("raw body not preserved: %q", apiErr.Body)
+	}
+}
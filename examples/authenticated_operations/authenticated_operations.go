@@ -21,7 +21,7 @@ func main() {
 	referendumID := 1234
 
 	// Add comment
-	comment, err := client.AddComment("ReferendumV2", referendumID,
+	comment, err := client.AddComment(polkassembly.ProposalTypeReferendumV2, referendumID,
 		polkassembly.AddCommentRequest{
 			Content: "This is my comment on the proposal",
 		})
@@ -31,14 +31,14 @@ func main() {
 	fmt.Printf("Added comment: %s\n", comment.ID)
 
 	// Add reaction
-	_, err = client.AddReaction("ReferendumV2", referendumID, "like")
+	_, err = client.AddReaction(polkassembly.ProposalTypeReferendumV2, referendumID, "like")
 	if err != nil {
 		log.Fatal(err)
 	}
 	fmt.Println("Added like reaction")
 
 	// Subscribe to updates
-	err = client.SubscribeProposal("ReferendumV2", referendumID)
+	err = client.SubscribeProposal(polkassembly.ProposalTypeReferendumV2, referendumID)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -13,24 +14,16 @@ func main() {
 	})
 
 	var allPosts []polkassembly.Post
-	page := 1
-
-	for {
-		resp, err := client.GetPosts(polkassembly.PostListingParams{
-			Page:         page,
-			ListingLimit: 100,
-			ProposalType: "ReferendumV2",
-		})
-		if err != nil {
-			log.Fatal(err)
-		}
 
-		allPosts = append(allPosts, resp.Posts...)
+	posts := client.AllPosts(context.Background(), polkassembly.PostListingParams{
+		ProposalType: polkassembly.ProposalTypeReferendumV2,
+	}, polkassembly.PageOptions{PageSize: 100, Prefetch: 2})
 
-		if len(resp.Posts) < 100 {
-			break
+	for post, err := range posts {
+		if err != nil {
+			log.Fatal(err)
 		}
-		page++
+		allPosts = append(allPosts, post)
 	}
 
 	fmt.Printf("Loaded %d referendums\n", len(allPosts))
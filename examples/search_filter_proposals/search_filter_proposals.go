@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -15,7 +16,7 @@ func main() {
 
 	// Get treasury proposals
 	treasuryProps, err := client.GetPosts(polkassembly.PostListingParams{
-		ProposalType: "TreasuryProposal",
+		ProposalType: polkassembly.ProposalTypeTreasuryProposal,
 		ListingLimit: 50,
 	})
 	if err != nil {
@@ -37,7 +38,7 @@ func main() {
 
 	// Get proposals by specific track
 	trackProps, err := client.GetPosts(polkassembly.PostListingParams{
-		ProposalType: "ReferendumV2",
+		ProposalType: polkassembly.ProposalTypeReferendumV2,
 		TrackNo:      1, // Root track
 		ListingLimit: 20,
 	})
@@ -46,4 +47,17 @@ func main() {
 	}
 
 	fmt.Printf("Track 1 proposals: %d\n", len(trackProps.Posts))
+
+	// Server-side full-text search across all posts
+	results := client.SearchAll(context.Background(), polkassembly.SearchParams{
+		Query: keyword,
+		Type:  "posts",
+	}, polkassembly.PageOptions{PageSize: 25, MaxItems: 100})
+
+	for result, err := range results {
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Search hit: #%d - %s\n", result.Post.Index, result.Post.Title)
+	}
 }
@@ -7,9 +7,17 @@ import (
 	"github.com/polkadot-go/polkassembly-api"
 )
 
+const (
+	network = "polkadot"
+	// totalIssuance is in whole tokens of network. Support is measured
+	// against it and the API does not report it; read it from the chain for
+	// accurate numbers.
+	totalIssuance = "1500000000"
+)
+
 func main() {
 	client := polkassembly.NewClient(polkassembly.Config{
-		Network: "polkadot",
+		Network: network,
 	})
 
 	referendumID := 1234
@@ -20,9 +28,39 @@ func main() {
 		log.Fatal(err)
 	}
 
+	token := client.Token()
 	fmt.Printf("Status: %s\n", data.Status)
-	fmt.Printf("Ayes: %d votes (%s DOT)\n", data.AyesCount, data.SupportAmount)
-	fmt.Printf("Nays: %d votes (%s DOT)\n", data.NaysCount, data.AgainstAmount)
+	fmt.Printf("Ayes: %d votes (%s)\n", data.AyesCount, data.SupportAmount.Format(token))
+	fmt.Printf("Nays: %d votes (%s)\n", data.NaysCount, data.AgainstAmount.Format(token))
+
+	issuance, err := token.Parse(totalIssuance)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	prediction, err := client.PredictReferendumOutcome(referendumID, issuance)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Track: %s\n", prediction.Track.Name)
+	fmt.Printf("Approval: %.2f%% (needs %.2f%%)\n", prediction.Approval*100, prediction.RequiredApproval*100)
+	fmt.Printf("Support: %.4f%% (needs %.4f%%)\n", prediction.Support*100, prediction.RequiredSupport*100)
+
+	switch {
+	case prediction.Passing:
+		fmt.Printf("Passing, confirmation would end %s\n", prediction.ConfirmEndsAt.Format("2006-01-02 15:04"))
+	case prediction.WouldConfirm:
+		fmt.Printf("Not passing yet, curves meet the tally at %s\n", prediction.ConfirmAt.Format("2006-01-02 15:04"))
+	default:
+		fmt.Println("Not passing and will not pass with the current tally")
+	}
+	if !prediction.ExtraAyes.IsZero() {
+		fmt.Printf("Needs %s more conviction-weighted ayes\n", prediction.ExtraAyes.Format(token))
+	}
+	if !prediction.ExtraSupport.IsZero() {
+		fmt.Printf("Needs %s more support\n", prediction.ExtraSupport.Format(token))
+	}
 
 	// Get voting curve
 	curve, err := client.GetVotingCurve(referendumID)
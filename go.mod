@@ -8,16 +8,17 @@ require (
 	github.com/ChainSafe/go-schnorrkel v1.1.0
 	github.com/go-resty/resty/v2 v2.16.5
 	github.com/vedhavyas/go-subkey/v2 v2.0.0
+	golang.org/x/crypto v0.40.0
 )
 
 require (
 	github.com/cosmos/go-bip39 v1.0.0 // indirect
 	github.com/decred/base58 v1.0.5 // indirect
 	github.com/decred/dcrd/crypto/blake256 v1.1.0 // indirect
+	github.com/ethereum/go-ethereum v1.10.20 // indirect
 	github.com/gtank/merlin v0.1.1 // indirect
 	github.com/gtank/ristretto255 v0.1.2 // indirect
 	github.com/mimoo/StrobeGo v0.0.0-20220103164710-9a04d6ca976b // indirect
-	golang.org/x/crypto v0.40.0 // indirect
 	golang.org/x/net v0.42.0 // indirect
 	golang.org/x/sys v0.34.0 // indirect
 )
@@ -0,0 +1,136 @@
+package polkassembly
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// GetNotifications retrieves a page of the user's notifications
+func (c *Client) GetNotifications(userID int, params NotificationListingParams) (*NotificationListingResponse, error) {
+	return c.GetNotificationsCtx(context.Background(), userID, params)
+}
+
+// GetNotificationsCtx is like GetNotifications but carries ctx for cancellation and deadlines
+func (c *Client) GetNotificationsCtx(ctx context.Context, userID int, params NotificationListingParams) (*NotificationListingResponse, error) {
+	queryParams := make(map[string]string)
+	if params.Page > 0 {
+		queryParams["page"] = fmt.Sprintf("%d", params.Page)
+	}
+	if params.Limit > 0 {
+		queryParams["limit"] = fmt.Sprintf("%d", params.Limit)
+	}
+	if params.UnreadOnly {
+		queryParams["unread"] = "true"
+	}
+
+	r, err := c.newRequest(ctx).
+		SetQueryParams(queryParams).
+		Get(fmt.Sprintf("/users/id/%d/notifications", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp NotificationListingResponse
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// AllNotifications iterates over every notification of the user
+func (c *Client) AllNotifications(ctx context.Context, userID int, params NotificationListingParams, opts PageOptions) iter.Seq2[Notification, error] {
+	size := opts.pageSize(params.Limit)
+	return paginate(ctx, size, opts, func(ctx context.Context, page, limit int) ([]Notification, int, error) {
+		p := params
+		p.Page, p.Limit = page, limit
+		resp, err := c.GetNotificationsCtx(ctx, userID, p)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Notifications, resp.TotalCount, nil
+	})
+}
+
+// MarkNotificationRead marks a single notification as read
+func (c *Client) MarkNotificationRead(userID, notificationID int) error {
+	return c.MarkNotificationReadCtx(context.Background(), userID, notificationID)
+}
+
+// MarkNotificationReadCtx is like MarkNotificationRead but carries ctx for cancellation and deadlines
+func (c *Client) MarkNotificationReadCtx(ctx context.Context, userID, notificationID int) error {
+	r, err := c.newRequest(ctx).
+		SetBody(map[string]bool{"isRead": true}).
+		Patch(fmt.Sprintf("/users/id/%d/notifications/%d", userID, notificationID))
+	if err != nil {
+		return err
+	}
+
+	return c.parseResponse(r, nil)
+}
+
+// MarkAllNotificationsRead marks every notification of the user as read
+func (c *Client) MarkAllNotificationsRead(userID int) error {
+	return c.MarkAllNotificationsReadCtx(context.Background(), userID)
+}
+
+// MarkAllNotificationsReadCtx is like MarkAllNotificationsRead but carries ctx for cancellation and deadlines
+func (c *Client) MarkAllNotificationsReadCtx(ctx context.Context, userID int) error {
+	r, err := c.newRequest(ctx).
+		SetBody(map[string]bool{"isRead": true}).
+		Patch(fmt.Sprintf("/users/id/%d/notifications", userID))
+	if err != nil {
+		return err
+	}
+
+	return c.parseResponse(r, nil)
+}
+
+// GetNotificationPreferences retrieves the user's notification settings
+func (c *Client) GetNotificationPreferences(userID int) (*NotificationPreferences, error) {
+	return c.GetNotificationPreferencesCtx(context.Background(), userID)
+}
+
+// GetNotificationPreferencesCtx is like GetNotificationPreferences but carries ctx for cancellation and deadlines
+func (c *Client) GetNotificationPreferencesCtx(ctx context.Context, userID int) (*NotificationPreferences, error) {
+	r, err := c.newRequest(ctx).
+		Get(fmt.Sprintf("/users/id/%d/notifications/preferences", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp NotificationPreferences
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateNotificationPreferences replaces the user's notification settings
+func (c *Client) UpdateNotificationPreferences(userID int, prefs NotificationPreferences) (*NotificationPreferences, error) {
+	return c.UpdateNotificationPreferencesCtx(context.Background(), userID, prefs)
+}
+
+// UpdateNotificationPreferencesCtx is like UpdateNotificationPreferences but carries ctx for cancellation and deadlines
+func (c *Client) UpdateNotificationPreferencesCtx(ctx context.Context, userID int, prefs NotificationPreferences) (*NotificationPreferences, error) {
+	r, err := c.newRequest(ctx).
+		SetBody(prefs).
+		Put(fmt.Sprintf("/users/id/%d/notifications/preferences", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	// The API may answer with 204 and no body
+	if len(r.Body()) == 0 && r.IsSuccess() {
+		return &prefs, nil
+	}
+
+	var resp NotificationPreferences
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
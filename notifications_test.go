@@ -0,0 +1,58 @@
+package polkassembly
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestAllNotificationsUnreadOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/id/9/notifications" || r.URL.Query().Get("unread") != "true" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		var items []Notification
+		for i := (page - 1) * 2; i < page*2 && i < 3; i++ {
+			items = append(items, Notification{ID: i + 1})
+		}
+		json.NewEncoder(w).Encode(NotificationListingResponse{Notifications: items, TotalCount: 3, UnreadCount: 3})
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+	var ids []int
+	for n, err := range c.AllNotifications(context.Background(), 9, NotificationListingParams{UnreadOnly: true}, PageOptions{PageSize: 2}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, n.ID)
+	}
+	if len(ids) != 3 || ids[2] != 3 {
+		t.Errorf("got %v", ids)
+	}
+}
+
+func TestUpdateNotificationPreferencesNoContent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || string(body) == "" {
+			t.Errorf("unexpected %s with body %s", r.Method, body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+	prefs, err := c.UpdateNotificationPreferences(9, NotificationPreferences{NewComment: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !prefs.NewComment || prefs.NewProposal {
+		t.Errorf("expected the sent preferences back, got %+v", prefs)
+	}
+}
@@ -0,0 +1,214 @@
+package polkassembly
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// decidingStatuses are the referendum statuses in which the curves apply
+var decidingStatuses = map[string]bool{
+	"Deciding":       true,
+	"ConfirmStarted": true,
+	"ConfirmAborted": true,
+}
+
+// preparingStatuses are the referendum statuses before deciding starts
+var preparingStatuses = map[string]bool{
+	"Submitted":             true,
+	"DecisionDepositPlaced": true,
+}
+
+// OutcomePrediction is the projected result of a referendum from its live
+// tally and its track's curves
+type OutcomePrediction struct {
+	Track  Track
+	Status string
+
+	// Current approval and support as fractions, and what the track
+	// requires at this point of the decision period
+	Approval         float64
+	Support          float64
+	RequiredApproval float64
+	RequiredSupport  float64
+
+	// Passing reports whether both thresholds are met now. Deciding is false
+	// in the prepare period and after the referendum has ended.
+	Passing    bool
+	Deciding   bool
+	Confirming bool
+
+	// WouldConfirm reports whether the referendum enters, or stays in,
+	// confirmation with the current tally before the decision period ends
+	WouldConfirm bool
+	// ConfirmAt is when the curves fall to the current tally and
+	// ConfirmEndsAt when confirmation would complete. Both are zero when the
+	// tally never meets the curves.
+	ConfirmAt     time.Time
+	ConfirmEndsAt time.Time
+
+	// ExtraAyes is the conviction-weighted aye vote needed to pass approval
+	// now, and ExtraSupport the additional aye or abstain balance needed to
+	// pass support now. Both are zero when the threshold is met.
+	ExtraAyes    Balance
+	ExtraSupport Balance
+}
+
+// PredictOutcome projects the outcome of a referendum. issuance is the
+// total issuance the runtime measures support against (excluding inactive
+// funds); the API does not report it, so it has to come from the chain.
+func PredictOutcome(post *Post, track Track, issuance Balance, now time.Time) (*OutcomePrediction, error) {
+	info := post.OnChainInfo
+	if info == nil {
+		return nil, fmt.Errorf("%w: post %d has no on-chain info", ErrValidation, post.Index)
+	}
+	if issuance.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: issuance must be positive", ErrValidation)
+	}
+
+	status := info.Status
+	if status == "" {
+		status = post.Status
+	}
+	tally := TallyFromMetrics(info.VoteMetrics)
+
+	p := &OutcomePrediction{
+		Track:      track,
+		Status:     status,
+		Approval:   tally.Approval(),
+		Support:    ratio(tally.Support, issuance),
+		Deciding:   decidingStatuses[status],
+		Confirming: status == "ConfirmStarted",
+	}
+
+	decisionPeriod := BlocksToDuration(track.DecisionPeriod)
+	var elapsed time.Duration
+	switch {
+	case p.Deciding:
+		if info.DecisionPeriodEndsAt.IsZero() {
+			return nil, fmt.Errorf("%w: post %d has no decision period end", ErrValidation, post.Index)
+		}
+		elapsed = max(0, now.Sub(info.DecisionPeriodEndsAt.Add(-decisionPeriod)))
+	case !preparingStatuses[status]:
+		// Ended referenda are evaluated against the final thresholds
+		elapsed = decisionPeriod
+	}
+	x := clampUnit(float64(elapsed) / float64(decisionPeriod))
+
+	p.RequiredApproval = track.MinApproval.Threshold(x)
+	p.RequiredSupport = track.MinSupport.Threshold(x)
+	p.Passing = p.Approval >= p.RequiredApproval && p.Support >= p.RequiredSupport
+	p.ExtraAyes = extraAyes(tally, p.RequiredApproval)
+	p.ExtraSupport = shortfall(tally.Support, issuance, p.RequiredSupport)
+
+	if !p.Deciding && !preparingStatuses[status] {
+		return p, nil
+	}
+
+	// The tally meets the curves once both have fallen to it
+	confirmX := max(track.MinApproval.Delay(p.Approval), track.MinSupport.Delay(p.Support))
+	neverMet := p.Approval < track.MinApproval.Threshold(1) || p.Support < track.MinSupport.Threshold(1)
+	if neverMet {
+		return p, nil
+	}
+
+	decidingStart := now
+	if p.Deciding {
+		decidingStart = now.Add(-elapsed)
+	} else if !info.PreparePeriodEndsAt.IsZero() && info.PreparePeriodEndsAt.After(now) {
+		decidingStart = info.PreparePeriodEndsAt
+	}
+
+	p.WouldConfirm = true
+	p.ConfirmAt = decidingStart.Add(time.Duration(confirmX * float64(decisionPeriod)))
+	if p.Passing && p.Deciding {
+		// Confirmation runs from now; when it already started the end is an
+		// upper bound
+		p.ConfirmAt = now
+	}
+	p.ConfirmEndsAt = p.ConfirmAt.Add(BlocksToDuration(track.ConfirmPeriod))
+
+	return p, nil
+}
+
+// PredictReferendumOutcome fetches an OpenGov referendum and predicts its
+// outcome with PredictOutcome using the track registry of the client's network
+func (c *Client) PredictReferendumOutcome(referendumIndex int, issuance Balance) (*OutcomePrediction, error) {
+	return c.PredictReferendumOutcomeCtx(context.Background(), referendumIndex, issuance)
+}
+
+// PredictReferendumOutcomeCtx is like PredictReferendumOutcome but carries ctx for cancellation and deadlines
+func (c *Client) PredictReferendumOutcomeCtx(ctx context.Context, referendumIndex int, issuance Balance) (*OutcomePrediction, error) {
+	post, err := c.GetPostByTypeCtx(ctx, referendumIndex, ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := c.trackForPost(post)
+	if err != nil {
+		return nil, err
+	}
+
+	return PredictOutcome(post, track, issuance, time.Now())
+}
+
+func (c *Client) trackForPost(post *Post) (Track, error) {
+	return postTrack(post, c.Network())
+}
+
+// postTrack looks up the track of a referendum by origin, falling back to
+// its track number. The post's own network wins over network.
+func postTrack(post *Post, network string) (Track, error) {
+	if post.Network != "" {
+		network = post.Network
+	}
+	if post.OnChainInfo != nil && post.OnChainInfo.Origin != "" {
+		return TrackByOrigin(network, post.OnChainInfo.Origin)
+	}
+	return TrackByID(network, post.TrackNumber)
+}
+
+// ratio returns a/b as a float
+func ratio(a, b Balance) float64 {
+	if b.IsZero() {
+		return 0
+	}
+	f, _ := new(big.Rat).SetFrac(a.Int(), b.Int()).Float64()
+	return f
+}
+
+// extraAyes returns the aye votes to add so that ayes/(ayes+nays) reaches
+// required. It is zero when approval is met and when no amount is enough.
+func extraAyes(t Tally, required float64) Balance {
+	if t.Approval() >= required || required >= 1 {
+		return Balance{}
+	}
+	// ayes' / (ayes' + nays) >= r  <=>  ayes' >= r * nays / (1 - r)
+	r := new(big.Rat).SetFloat64(required)
+	need := new(big.Rat).Mul(r, new(big.Rat).SetInt(t.Nays.Int()))
+	need.Quo(need, new(big.Rat).Sub(big.NewRat(1, 1), r))
+	diff := ceilBalance(need).Sub(t.Ayes)
+	if diff.Sign() <= 0 {
+		return Balance{}
+	}
+	return diff
+}
+
+// shortfall returns how far have is below required * of
+func shortfall(have, of Balance, required float64) Balance {
+	need := new(big.Rat).Mul(new(big.Rat).SetFloat64(required), new(big.Rat).SetInt(of.Int()))
+	diff := ceilBalance(need).Sub(have)
+	if diff.Sign() <= 0 {
+		return Balance{}
+	}
+	return diff
+}
+
+func ceilBalance(r *big.Rat) Balance {
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if m.Sign() > 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return Balance{v: q}
+}
@@ -0,0 +1,85 @@
+package polkassembly
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func decidingPost(ayes, nays, support int64, endsIn time.Duration, now time.Time) *Post {
+	post := &Post{Index: 1, OnChainInfo: &OnChainInfo{
+		Status:               "Deciding",
+		DecisionPeriodEndsAt: now.Add(endsIn),
+	}}
+	m := &post.OnChainInfo.VoteMetrics
+	m.Aye.Value = NewBalanceInt64(ayes)
+	m.Nay.Value = NewBalanceInt64(nays)
+	m.Support.Value = NewBalanceInt64(support)
+	return post
+}
+
+func TestPredictOutcomePassing(t *testing.T) {
+	track, _ := TrackByID("polkadot", 33)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	issuance := NewBalanceInt64(1_000_000)
+
+	// Half way through: approval requires ~79.5% and support ~0.7%
+	post := decidingPost(900, 100, 100_000, 14*24*time.Hour, now)
+	p, err := PredictOutcome(post, track, issuance, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Deciding || !p.Passing || !p.WouldConfirm {
+		t.Fatalf("expected passing referendum, got %+v", p)
+	}
+	if !p.ConfirmAt.Equal(now) || p.ConfirmEndsAt.Sub(now) != 24*time.Hour {
+		t.Errorf("confirmation: %v to %v", p.ConfirmAt, p.ConfirmEndsAt)
+	}
+	if !p.ExtraAyes.IsZero() || !p.ExtraSupport.IsZero() {
+		t.Errorf("expected no shortfall, got %s ayes %s support", p.ExtraAyes, p.ExtraSupport)
+	}
+}
+
+func TestPredictOutcomeFailingApproval(t *testing.T) {
+	track, _ := TrackByID("polkadot", 33)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	issuance := NewBalanceInt64(1_000_000)
+
+	// Day one with 60% approval: not passing yet, but the approval curve
+	// reaches 60% before the end so it would confirm later
+	post := decidingPost(600, 400, 400_000, 27*24*time.Hour, now)
+	p, err := PredictOutcome(post, track, issuance, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Passing || !p.WouldConfirm || !p.ConfirmAt.After(now) {
+		t.Fatalf("unexpected prediction %+v", p)
+	}
+	want := now.Add(-24 * time.Hour).Add(time.Duration(track.MinApproval.Delay(0.6) * float64(BlocksToDuration(track.DecisionPeriod))))
+	if d := p.ConfirmAt.Sub(want); d > time.Second || d < -time.Second {
+		t.Errorf("confirm at %v, want %v", p.ConfirmAt, want)
+	}
+
+	// The extra ayes bring approval exactly to the requirement
+	ayes := NewBalanceInt64(600).Add(p.ExtraAyes)
+	if got := (Tally{Ayes: ayes, Nays: NewBalanceInt64(400)}).Approval(); got < p.RequiredApproval {
+		t.Errorf("approval with extra ayes %v < required %v", got, p.RequiredApproval)
+	}
+
+	// Below the approval floor it never confirms
+	post = decidingPost(400, 600, 400_000, 27*24*time.Hour, now)
+	if p, _ := PredictOutcome(post, track, issuance, now); p.WouldConfirm || !p.ConfirmAt.IsZero() {
+		t.Errorf("expected no confirmation, got %+v", p)
+	}
+}
+
+func TestPredictOutcomeValidation(t *testing.T) {
+	track, _ := TrackByID("polkadot", 0)
+	if _, err := PredictOutcome(&Post{}, track, NewBalanceInt64(1), time.Now()); !errors.Is(err, ErrValidation) {
+		t.Errorf("missing on-chain info: got %v", err)
+	}
+	post := decidingPost(1, 0, 1, time.Hour, time.Now())
+	if _, err := PredictOutcome(post, track, Balance{}, time.Now()); !errors.Is(err, ErrValidation) {
+		t.Errorf("zero issuance: got %v", err)
+	}
+}
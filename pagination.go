@@ -0,0 +1,215 @@
+package polkassembly
+
+import (
+	"context"
+	"iter"
+)
+
+const defaultPageSize = 50
+
+// PageOptions controls the listing iterators
+type PageOptions struct {
+	// PageSize is the number of items requested per page. Defaults to 50.
+	PageSize int
+	// MaxItems stops iteration after this many items. Zero means no limit.
+	MaxItems int
+	// Prefetch is the number of pages fetched ahead of the consumer
+	// concurrently. Zero fetches pages one at a time.
+	Prefetch int
+}
+
+func (o PageOptions) pageSize(fallback int) int {
+	if o.PageSize > 0 {
+		return o.PageSize
+	}
+	if fallback > 0 {
+		return fallback
+	}
+	return defaultPageSize
+}
+
+// pageFetcher loads one page. total is the number of items across all pages,
+// or 0 when the endpoint does not report it. Listing responses report it as
+// count (or totalCount for posts); endpoints returning a bare array, such as
+// delegates and the activity feed, cannot, so their iterators stop on the
+// first short page.
+type pageFetcher[T any] func(ctx context.Context, page, limit int) (items []T, total int, err error)
+
+type pageResult[T any] struct {
+	items []T
+	total int
+	err   error
+}
+
+// paginate turns a page fetcher into an iterator. Iteration ends when the
+// reported total is reached, a short or empty page is returned, MaxItems is
+// hit, the consumer stops, or ctx is done. Errors are yielded once and end
+// the sequence.
+func paginate[T any](ctx context.Context, size int, opts PageOptions, fetch pageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var zero T
+		var pending []chan pageResult[T]
+		nextPage, lastPage := 1, 0
+
+		// The first page is fetched alone so a reported total can cap how
+		// far ahead later pages are prefetched.
+		window := 0
+		launch := func() {
+			for len(pending) <= window && (lastPage == 0 || nextPage <= lastPage) {
+				ch := make(chan pageResult[T], 1)
+				go func(page int) {
+					items, total, err := fetch(ctx, page, size)
+					ch <- pageResult[T]{items: items, total: total, err: err}
+				}(nextPage)
+				pending = append(pending, ch)
+				nextPage++
+			}
+		}
+
+		seen, yielded := 0, 0
+		launch()
+		for len(pending) > 0 {
+			ch := pending[0]
+			pending = pending[1:]
+
+			var res pageResult[T]
+			select {
+			case res = <-ch:
+			case <-ctx.Done():
+				yield(zero, ctx.Err())
+				return
+			}
+			if res.err != nil {
+				yield(zero, res.err)
+				return
+			}
+			if res.total > 0 && lastPage == 0 {
+				lastPage = (res.total + size - 1) / size
+			}
+			window = opts.Prefetch
+
+			for _, item := range res.items {
+				if !yield(item, nil) {
+					return
+				}
+				yielded++
+				if opts.MaxItems > 0 && yielded >= opts.MaxItems {
+					return
+				}
+			}
+
+			seen += len(res.items)
+			if len(res.items) == 0 {
+				return
+			}
+			if res.total > 0 && seen >= res.total {
+				return
+			}
+			if res.total <= 0 && len(res.items) < size {
+				return
+			}
+			launch()
+		}
+	}
+}
+
+// AllPosts iterates over every post matching params. params.Page is ignored
+// and params.ListingLimit is used as the page size unless opts.PageSize is set.
+func (c *Client) AllPosts(ctx context.Context, params PostListingParams, opts PageOptions) iter.Seq2[Post, error] {
+	size := opts.pageSize(params.ListingLimit)
+	return paginate(ctx, size, opts, func(ctx context.Context, page, limit int) ([]Post, int, error) {
+		p := params
+		p.Page, p.ListingLimit = page, limit
+		resp, err := c.GetPostsCtx(ctx, p)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Posts, resp.TotalCount, nil
+	})
+}
+
+// AllVotes iterates over every vote on a proposal of the given type
+func (c *Client) AllVotes(ctx context.Context, params VoteListingParams, proposalType ProposalType, opts PageOptions) iter.Seq2[Vote, error] {
+	size := opts.pageSize(params.Limit)
+	return paginate(ctx, size, opts, func(ctx context.Context, page, limit int) ([]Vote, int, error) {
+		p := params
+		p.Page, p.Limit = page, limit
+		resp, err := c.GetVotesByTypeCtx(ctx, p, proposalType)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Votes, resp.Count, nil
+	})
+}
+
+// AllVotesByAddress iterates over every vote cast by address on a proposal
+func (c *Client) AllVotesByAddress(ctx context.Context, proposalType ProposalType, postID int, address string, opts PageOptions) iter.Seq2[Vote, error] {
+	return paginate(ctx, opts.pageSize(0), opts, func(ctx context.Context, page, limit int) ([]Vote, int, error) {
+		resp, err := c.GetVotesByAddressCtx(ctx, proposalType, postID, address, page, limit)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Votes, resp.Count, nil
+	})
+}
+
+// AllUsers iterates over every user matching params
+func (c *Client) AllUsers(ctx context.Context, params UserListingParams, opts PageOptions) iter.Seq2[User, error] {
+	size := opts.pageSize(params.Limit)
+	return paginate(ctx, size, opts, func(ctx context.Context, page, limit int) ([]User, int, error) {
+		p := params
+		p.Page, p.Limit = page, limit
+		resp, err := c.GetUsersCtx(ctx, p)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Users, resp.Count, nil
+	})
+}
+
+// AllUserFollowers iterates over every follower of a user
+func (c *Client) AllUserFollowers(ctx context.Context, userID int, opts PageOptions) iter.Seq2[User, error] {
+	return paginate(ctx, opts.pageSize(0), opts, func(ctx context.Context, page, limit int) ([]User, int, error) {
+		resp, err := c.GetUserFollowersCtx(ctx, userID, page, limit)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Users, resp.Count, nil
+	})
+}
+
+// AllDelegates iterates over every delegate. The endpoint returns no total,
+// so a list that is an exact multiple of the page size costs one extra
+// empty request.
+func (c *Client) AllDelegates(ctx context.Context, opts PageOptions) iter.Seq2[Delegate, error] {
+	return paginate(ctx, opts.pageSize(0), opts, func(ctx context.Context, page, limit int) ([]Delegate, int, error) {
+		delegates, err := c.GetDelegatesCtx(ctx, page, limit)
+		return delegates, 0, err
+	})
+}
+
+// AllPreimages iterates over every preimage matching params
+func (c *Client) AllPreimages(ctx context.Context, params PreimageListingParams, opts PageOptions) iter.Seq2[Preimage, error] {
+	size := opts.pageSize(params.Limit)
+	return paginate(ctx, size, opts, func(ctx context.Context, page, limit int) ([]Preimage, int, error) {
+		p := params
+		p.Page, p.Limit = page, limit
+		resp, err := c.GetPreimagesCtx(ctx, p)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Preimages, resp.Count, nil
+	})
+}
+
+// AllActivityFeed iterates over the whole activity feed. Like AllDelegates
+// it has no total to stop at.
+func (c *Client) AllActivityFeed(ctx context.Context, opts PageOptions) iter.Seq2[ActivityFeedItem, error] {
+	return paginate(ctx, opts.pageSize(0), opts, func(ctx context.Context, page, limit int) ([]ActivityFeedItem, int, error) {
+		items, err := c.GetActivityFeedCtx(ctx, page, limit)
+		return items, 0, err
+	})
+}
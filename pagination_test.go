@@ -0,0 +1,150 @@
+package polkassembly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func newPostsServer(t *testing.T, total int, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		var items []Post
+		for i := (page - 1) * limit; i < page*limit && i < total; i++ {
+			items = append(items, Post{Index: i + 1})
+		}
+		json.NewEncoder(w).Encode(PostListingResponse{Items: items, TotalCount: total})
+	}))
+}
+
+func TestAllPostsPagesUntilTotal(t *testing.T) {
+	for _, prefetch := range []int{0, 3} {
+		var calls int32
+		srv := newPostsServer(t, 25, &calls)
+
+		c := NewClient(Config{BaseURL: srv.URL})
+		var indexes []int
+		for post, err := range c.AllPosts(context.Background(), PostListingParams{}, PageOptions{PageSize: 10, Prefetch: prefetch}) {
+			if err != nil {
+				t.Fatalf("iteration failed: %v", err)
+			}
+			indexes = append(indexes, post.Index)
+		}
+		srv.Close()
+
+		if len(indexes) != 25 {
+			t.Fatalf("prefetch %d: expected 25 posts, got %d", prefetch, len(indexes))
+		}
+		for i, idx := range indexes {
+			if idx != i+1 {
+				t.Fatalf("prefetch %d: posts out of order at %d: %d", prefetch, i, idx)
+			}
+		}
+		if got := atomic.LoadInt32(&calls); got != 3 {
+			t.Errorf("prefetch %d: expected 3 page requests, got %d", prefetch, got)
+		}
+	}
+}
+
+func TestAllPostsMaxItemsAndBreak(t *testing.T) {
+	var calls int32
+	srv := newPostsServer(t, 100, &calls)
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL})
+
+	count := 0
+	for _, err := range c.AllPosts(context.Background(), PostListingParams{}, PageOptions{PageSize: 10, MaxItems: 15}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		count++
+	}
+	if count != 15 {
+		t.Errorf("expected 15 posts, got %d", count)
+	}
+
+	count = 0
+	for range c.AllPosts(context.Background(), PostListingParams{}, PageOptions{PageSize: 10}) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("expected to stop after 3 posts, got %d", count)
+	}
+}
+
+func TestAllUsersStopsOnError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(UserListingResponse{Users: make([]User, 5)})
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL})
+
+	count := 0
+	var lastErr error
+	for _, err := range c.AllUsers(context.Background(), UserListingParams{}, PageOptions{PageSize: 5}) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		count++
+	}
+	if count != 5 || !errors.Is(lastErr, ErrNotFound) {
+		t.Fatalf("expected 5 users then not found, got %d and %v", count, lastErr)
+	}
+}
+
+func TestPrefetchStopsAtReportedTotal(t *testing.T) {
+	for _, total := range []int{25, 30} {
+		var calls, maxPage int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			for {
+				m := atomic.LoadInt32(&maxPage)
+				if int32(page) <= m || atomic.CompareAndSwapInt32(&maxPage, m, int32(page)) {
+					break
+				}
+			}
+
+			var votes []Vote
+			for i := (page - 1) * 10; i < page*10 && i < total; i++ {
+				votes = append(votes, Vote{ID: strconv.Itoa(i)})
+			}
+			json.NewEncoder(w).Encode(VoteListingResponse{Votes: votes, Count: total})
+		}))
+
+		c := NewClient(Config{BaseURL: srv.URL})
+		count := 0
+		for _, err := range c.AllVotes(context.Background(), VoteListingParams{PostID: 1}, ProposalTypeReferendumV2, PageOptions{PageSize: 10, Prefetch: 5}) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			count++
+		}
+		srv.Close()
+
+		if count != total {
+			t.Errorf("total %d: got %d votes", total, count)
+		}
+		if calls != 3 || maxPage != 3 {
+			t.Errorf("total %d: requested %d pages up to page %d, want 3", total, calls, maxPage)
+		}
+	}
+}
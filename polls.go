@@ -0,0 +1,181 @@
+package polkassembly
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Poll statuses derived from a poll's end time
+const (
+	PollStatusActive = "active"
+	PollStatusEnded  = "ended"
+)
+
+// GetPolls retrieves the polls attached to a post
+func (c *Client) GetPolls(proposalType ProposalType, postID int) ([]Poll, error) {
+	return c.GetPollsCtx(context.Background(), proposalType, postID)
+}
+
+// GetPollsCtx is like GetPolls but carries ctx for cancellation and deadlines
+func (c *Client) GetPollsCtx(ctx context.Context, proposalType ProposalType, postID int) ([]Poll, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := c.newRequest(ctx).
+		Get(fmt.Sprintf("/%s/%d/polls", proposalType, postID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Polls []Poll `json:"polls"`
+	}
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range resp.Polls {
+		resp.Polls[i].computeResults(now)
+	}
+
+	return resp.Polls, nil
+}
+
+// CreatePoll creates a poll on the post identified by req.PostID
+func (c *Client) CreatePoll(proposalType ProposalType, req CreatePollRequest) (*Poll, error) {
+	return c.CreatePollCtx(context.Background(), proposalType, req)
+}
+
+// CreatePollCtx is like CreatePoll but carries ctx for cancellation and deadlines
+func (c *Client) CreatePollCtx(ctx context.Context, proposalType ProposalType, req CreatePollRequest) (*Poll, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Options) < 2 {
+		return nil, fmt.Errorf("%w: a poll needs at least two options", ErrValidation)
+	}
+	if !req.EndAt.IsZero() && !req.EndAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: poll end time must be in the future", ErrValidation)
+	}
+
+	r, err := c.newRequest(ctx).
+		SetBody(req).
+		Post(fmt.Sprintf("/%s/%d/polls", proposalType, req.PostID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp Poll
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	resp.computeResults(time.Now())
+	return &resp, nil
+}
+
+// VotePoll casts a vote on a poll option
+func (c *Client) VotePoll(proposalType ProposalType, postID, pollID int, req PollVoteRequest) error {
+	return c.VotePollCtx(context.Background(), proposalType, postID, pollID, req)
+}
+
+// VotePollCtx is like VotePoll but carries ctx for cancellation and deadlines
+func (c *Client) VotePollCtx(ctx context.Context, proposalType ProposalType, postID, pollID int, req PollVoteRequest) error {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return err
+	}
+
+	r, err := c.newRequest(ctx).
+		SetBody(req).
+		Post(fmt.Sprintf("/%s/%d/polls/%d/votes", proposalType, postID, pollID))
+	if err != nil {
+		return err
+	}
+
+	return c.parseResponse(r, nil)
+}
+
+// ChangePollVote moves the current user's vote to another option
+func (c *Client) ChangePollVote(proposalType ProposalType, postID, pollID int, req PollVoteRequest) error {
+	return c.ChangePollVoteCtx(context.Background(), proposalType, postID, pollID, req)
+}
+
+// ChangePollVoteCtx is like ChangePollVote but carries ctx for cancellation and deadlines
+func (c *Client) ChangePollVoteCtx(ctx context.Context, proposalType ProposalType, postID, pollID int, req PollVoteRequest) error {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return err
+	}
+
+	r, err := c.newRequest(ctx).
+		SetBody(req).
+		Patch(fmt.Sprintf("/%s/%d/polls/%d/votes", proposalType, postID, pollID))
+	if err != nil {
+		return err
+	}
+
+	return c.parseResponse(r, nil)
+}
+
+// GetPollResults retrieves a poll with per-option percentages and a status
+// derived from its end time
+func (c *Client) GetPollResults(proposalType ProposalType, postID, pollID int) (*Poll, error) {
+	return c.GetPollResultsCtx(context.Background(), proposalType, postID, pollID)
+}
+
+// GetPollResultsCtx is like GetPollResults but carries ctx for cancellation and deadlines
+func (c *Client) GetPollResultsCtx(ctx context.Context, proposalType ProposalType, postID, pollID int) (*Poll, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := c.newRequest(ctx).
+		Get(fmt.Sprintf("/%s/%d/polls/%d", proposalType, postID, pollID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp Poll
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	resp.computeResults(time.Now())
+	return &resp, nil
+}
+
+// IsEnded reports whether the poll stopped accepting votes at now
+func (p *Poll) IsEnded(now time.Time) bool {
+	return !p.EndAt.IsZero() && !now.Before(p.EndAt)
+}
+
+// computeResults fills in option percentages, the voter count and the status
+func (p *Poll) computeResults(now time.Time) {
+	total := 0
+	for _, opt := range p.Options {
+		total += opt.VoteCount
+	}
+	for i := range p.Options {
+		if total == 0 {
+			p.Options[i].Percentage = 0
+			continue
+		}
+		p.Options[i].Percentage = float64(p.Options[i].VoteCount) * 100 / float64(total)
+	}
+	if p.VoterCount == 0 {
+		p.VoterCount = total
+	}
+
+	if p.IsEnded(now) {
+		p.Status = PollStatusEnded
+	} else {
+		p.Status = PollStatusActive
+	}
+}
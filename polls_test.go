@@ -0,0 +1,74 @@
+package polkassembly
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestPollComputeResults(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	p := Poll{
+		EndAt: now.Add(time.Hour),
+		Options: []PollOption{
+			{ID: 1, VoteCount: 3},
+			{ID: 2, VoteCount: 1},
+			{ID: 3, VoteCount: 0},
+		},
+	}
+	p.computeResults(now)
+	if p.Options[0].Percentage != 75 || p.Options[1].Percentage != 25 || p.Options[2].Percentage != 0 {
+		t.Errorf("percentages: %+v", p.Options)
+	}
+	if p.VoterCount != 4 || p.Status != PollStatusActive {
+		t.Errorf("got %d voters, status %s", p.VoterCount, p.Status)
+	}
+
+	// A reported voter count is kept, and the end time is inclusive
+	p.VoterCount = 10
+	p.computeResults(now.Add(time.Hour))
+	if p.VoterCount != 10 || p.Status != PollStatusEnded {
+		t.Errorf("got %d voters, status %s", p.VoterCount, p.Status)
+	}
+
+	empty := Poll{Options: []PollOption{{ID: 1, Percentage: 40}}}
+	empty.computeResults(now)
+	if empty.Options[0].Percentage != 0 || empty.Status != PollStatusActive {
+		t.Errorf("poll without votes or end: %+v", empty)
+	}
+}
+
+func TestGetPollResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Discussion/12/polls/3" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"id":3,"question":"Ship it?","end_at":"2020-01-01T00:00:00Z",
+			"options":[{"id":1,"text":"Yes","vote_count":2},{"id":2,"text":"No","vote_count":2}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+	poll, err := c.GetPollResults(ProposalTypeDiscussion, 12, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if poll.Status != PollStatusEnded || poll.VoterCount != 4 || poll.Options[1].Percentage != 50 {
+		t.Errorf("unexpected poll %+v", poll)
+	}
+}
+
+func TestCreatePollValidation(t *testing.T) {
+	c := NewClient(Config{BaseURL: "http://127.0.0.1:0", Network: "polkadot"})
+	for name, req := range map[string]CreatePollRequest{
+		"one option": {Question: "Ship it?", Options: []string{"Yes"}},
+		"past end":   {Question: "Ship it?", Options: []string{"Yes", "No"}, EndAt: time.Now().Add(-time.Minute)},
+	} {
+		if _, err := c.CreatePoll(ProposalTypeReferendumV2, req); !errors.Is(err, ErrValidation) {
+			t.Errorf("%s: expected ErrValidation, got %v", name, err)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package polkassembly
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -8,10 +9,15 @@ import (
 // GetPosts retrieves posts based on proposal type
 // The API expects: /api/v2/{proposalType}
 func (c *Client) GetPosts(params PostListingParams) (*PostListingResponse, error) {
+	return c.GetPostsCtx(context.Background(), params)
+}
+
+// GetPostsCtx is like GetPosts but carries ctx for cancellation and deadlines
+func (c *Client) GetPostsCtx(ctx context.Context, params PostListingParams) (*PostListingResponse, error) {
 	// Default to ReferendumV2 if no type specified
-	proposalType := params.ProposalType
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+	proposalType, err := params.ProposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
 	}
 
 	queryParams := make(map[string]string)
@@ -33,14 +39,20 @@ func (c *Client) GetPosts(params PostListingParams) (*PostListingResponse, error
 	if params.Origin != "" {
 		queryParams["origin"] = params.Origin
 	}
+	if params.SearchTerm != "" {
+		queryParams["search"] = params.SearchTerm
+	}
 
 	// The API expects proposalType as the main path
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetQueryParams(queryParams).
 		Get(fmt.Sprintf("/%s", proposalType))
 	if err != nil {
 		return nil, err
 	}
+	if err := c.checkResponse(r); err != nil {
+		return nil, err
+	}
 
 	// Parse directly as PostListingResponse
 	var resp PostListingResponse
@@ -80,20 +92,34 @@ func (c *Client) GetPosts(params PostListingParams) (*PostListingResponse, error
 // GetPost retrieves a single post by ID
 // The API expects: /api/v2/{proposalType}/{postId}
 func (c *Client) GetPost(postID int) (*Post, error) {
-	return c.GetPostByType(postID, "ReferendumV2")
+	return c.GetPostCtx(context.Background(), postID)
+}
+
+// GetPostCtx is like GetPost but carries ctx for cancellation and deadlines
+func (c *Client) GetPostCtx(ctx context.Context, postID int) (*Post, error) {
+	return c.GetPostByTypeCtx(ctx, postID, ProposalTypeReferendumV2)
 }
 
 // GetPostByType retrieves a single post by ID and type
-func (c *Client) GetPostByType(postID int, proposalType string) (*Post, error) {
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+func (c *Client) GetPostByType(postID int, proposalType ProposalType) (*Post, error) {
+	return c.GetPostByTypeCtx(context.Background(), postID, proposalType)
+}
+
+// GetPostByTypeCtx is like GetPostByType but carries ctx for cancellation and deadlines
+func (c *Client) GetPostByTypeCtx(ctx context.Context, postID int, proposalType ProposalType) (*Post, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/%s/%d", proposalType, postID))
 	if err != nil {
 		return nil, err
 	}
+	if err := c.checkResponse(r); err != nil {
+		return nil, err
+	}
 
 	// Parse directly - single post responses may not be wrapped
 	var resp Post
@@ -121,17 +147,28 @@ func (c *Client) GetPostByType(postID int, proposalType string) (*Post, error) {
 
 // GetPostOnchainData retrieves onchain data for a post
 func (c *Client) GetPostOnchainData(postID int) (*PostOnchainData, error) {
-	return c.GetPostOnchainDataByType(postID, "ReferendumV2")
+	return c.GetPostOnchainDataCtx(context.Background(), postID)
+}
+
+// GetPostOnchainDataCtx is like GetPostOnchainData but carries ctx for cancellation and deadlines
+func (c *Client) GetPostOnchainDataCtx(ctx context.Context, postID int) (*PostOnchainData, error) {
+	return c.GetPostOnchainDataByTypeCtx(ctx, postID, ProposalTypeReferendumV2)
 }
 
 // GetPostOnchainDataByType retrieves onchain data for a post by type
-func (c *Client) GetPostOnchainDataByType(postID int, proposalType string) (*PostOnchainData, error) {
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+func (c *Client) GetPostOnchainDataByType(postID int, proposalType ProposalType) (*PostOnchainData, error) {
+	return c.GetPostOnchainDataByTypeCtx(context.Background(), postID, proposalType)
+}
+
+// GetPostOnchainDataByTypeCtx is like GetPostOnchainDataByType but carries ctx for cancellation and deadlines
+func (c *Client) GetPostOnchainDataByTypeCtx(ctx context.Context, postID int, proposalType ProposalType) (*PostOnchainData, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
 	}
 
 	// v2 API returns onchain data in the main post endpoint
-	post, err := c.GetPostByType(postID, proposalType)
+	post, err := c.GetPostByTypeCtx(ctx, postID, proposalType)
 	if err != nil {
 		return nil, err
 	}
@@ -160,20 +197,34 @@ func (c *Client) GetPostOnchainDataByType(postID int, proposalType string) (*Pos
 
 // GetPostComments retrieves comments for a post
 func (c *Client) GetPostComments(postID int) ([]Comment, error) {
-	return c.GetPostCommentsByType(postID, "ReferendumV2")
+	return c.GetPostCommentsCtx(context.Background(), postID)
+}
+
+// GetPostCommentsCtx is like GetPostComments but carries ctx for cancellation and deadlines
+func (c *Client) GetPostCommentsCtx(ctx context.Context, postID int) ([]Comment, error) {
+	return c.GetPostCommentsByTypeCtx(ctx, postID, ProposalTypeReferendumV2)
 }
 
 // GetPostCommentsByType retrieves comments for a post by type
-func (c *Client) GetPostCommentsByType(postID int, proposalType string) ([]Comment, error) {
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+func (c *Client) GetPostCommentsByType(postID int, proposalType ProposalType) ([]Comment, error) {
+	return c.GetPostCommentsByTypeCtx(context.Background(), postID, proposalType)
+}
+
+// GetPostCommentsByTypeCtx is like GetPostCommentsByType but carries ctx for cancellation and deadlines
+func (c *Client) GetPostCommentsByTypeCtx(ctx context.Context, postID int, proposalType ProposalType) ([]Comment, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/%s/%d/comments", proposalType, postID))
 	if err != nil {
 		return nil, err
 	}
+	if err := c.checkResponse(r); err != nil {
+		return nil, err
+	}
 
 	// Try parsing as array first
 	var comments []Comment
@@ -194,20 +245,34 @@ func (c *Client) GetPostCommentsByType(postID int, proposalType string) ([]Comme
 
 // GetContentSummary retrieves AI-generated summary for a post
 func (c *Client) GetContentSummary(postID int) (*ContentSummary, error) {
-	return c.GetContentSummaryByType(postID, "ReferendumV2")
+	return c.GetContentSummaryCtx(context.Background(), postID)
+}
+
+// GetContentSummaryCtx is like GetContentSummary but carries ctx for cancellation and deadlines
+func (c *Client) GetContentSummaryCtx(ctx context.Context, postID int) (*ContentSummary, error) {
+	return c.GetContentSummaryByTypeCtx(ctx, postID, ProposalTypeReferendumV2)
 }
 
 // GetContentSummaryByType retrieves AI-generated summary for a post by type
-func (c *Client) GetContentSummaryByType(postID int, proposalType string) (*ContentSummary, error) {
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+func (c *Client) GetContentSummaryByType(postID int, proposalType ProposalType) (*ContentSummary, error) {
+	return c.GetContentSummaryByTypeCtx(context.Background(), postID, proposalType)
+}
+
+// GetContentSummaryByTypeCtx is like GetContentSummaryByType but carries ctx for cancellation and deadlines
+func (c *Client) GetContentSummaryByTypeCtx(ctx context.Context, postID int, proposalType ProposalType) (*ContentSummary, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/%s/%d/content-summary", proposalType, postID))
 	if err != nil {
 		return nil, err
 	}
+	if err := c.checkResponse(r); err != nil {
+		return nil, err
+	}
 
 	var resp ContentSummary
 	if err := json.Unmarshal(r.Body(), &resp); err != nil {
@@ -219,6 +284,11 @@ func (c *Client) GetContentSummaryByType(postID int, proposalType string) (*Cont
 
 // GetActivityFeed retrieves the activity feed
 func (c *Client) GetActivityFeed(page, limit int) ([]ActivityFeedItem, error) {
+	return c.GetActivityFeedCtx(context.Background(), page, limit)
+}
+
+// GetActivityFeedCtx is like GetActivityFeed but carries ctx for cancellation and deadlines
+func (c *Client) GetActivityFeedCtx(ctx context.Context, page, limit int) ([]ActivityFeedItem, error) {
 	queryParams := make(map[string]string)
 	if page > 0 {
 		queryParams["page"] = fmt.Sprintf("%d", page)
@@ -227,7 +297,7 @@ func (c *Client) GetActivityFeed(page, limit int) ([]ActivityFeedItem, error) {
 		queryParams["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetQueryParams(queryParams).
 		Get("/activity-feed")
 	if err != nil {
@@ -245,12 +315,17 @@ func (c *Client) GetActivityFeed(page, limit int) ([]ActivityFeedItem, error) {
 	return resp.Items, nil
 }
 
-func (c *Client) IsSubscribed(proposalType string, postID int) (*SubscriptionStatus, error) {
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+func (c *Client) IsSubscribed(proposalType ProposalType, postID int) (*SubscriptionStatus, error) {
+	return c.IsSubscribedCtx(context.Background(), proposalType, postID)
+}
+
+func (c *Client) IsSubscribedCtx(ctx context.Context, proposalType ProposalType, postID int) (*SubscriptionStatus, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/%s/%d/subscription", proposalType, postID))
 
 	if err != nil {
@@ -272,16 +347,33 @@ func (c *Client) IsSubscribed(proposalType string, postID int) (*SubscriptionSta
 }
 
 // CreateOffchainPost creates an offchain discussion post
-func (c *Client) CreateOffchainPost(proposalType string, req CreateOffchainPostRequest) (*Post, error) {
-	if proposalType == "" {
-		proposalType = "Discussion"
+func (c *Client) CreateOffchainPost(proposalType ProposalType, req CreateOffchainPostRequest) (*Post, error) {
+	return c.CreateOffchainPostCtx(context.Background(), proposalType, req)
+}
+
+// CreateOffchainPostCtx is like CreateOffchainPost but carries ctx for cancellation and deadlines
+func (c *Client) CreateOffchainPostCtx(ctx context.Context, proposalType ProposalType, req CreateOffchainPostRequest) (*Post, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeDiscussion)
+	if err != nil {
+		return nil, err
+	}
+	if !proposalType.IsOffChain() {
+		return nil, fmt.Errorf("%w: %s posts are created on-chain", ErrValidation, proposalType)
+	}
+
+	body := map[string]interface{}{
+		"title":   req.Title,
+		"content": req.Content,
+	}
+	if req.TopicID > 0 {
+		body["topicId"] = req.TopicID
+	}
+	if len(req.Tags) > 0 {
+		body["tags"] = req.Tags
 	}
 
-	r, err := c.client.R().
-		SetBody(map[string]interface{}{
-			"title":   req.Title,
-			"content": req.Content,
-		}).
+	r, err := c.newRequest(ctx).
+		SetBody(body).
 		Post(fmt.Sprintf("/%s", proposalType))
 	if err != nil {
 		return nil, err
@@ -296,9 +388,15 @@ func (c *Client) CreateOffchainPost(proposalType string, req CreateOffchainPostR
 }
 
 // UpdatePost updates an existing post
-func (c *Client) UpdatePost(proposalType string, postID int, req UpdatePostRequest) (*Post, error) {
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+func (c *Client) UpdatePost(proposalType ProposalType, postID int, req UpdatePostRequest) (*Post, error) {
+	return c.UpdatePostCtx(context.Background(), proposalType, postID, req)
+}
+
+// UpdatePostCtx is like UpdatePost but carries ctx for cancellation and deadlines
+func (c *Client) UpdatePostCtx(ctx context.Context, proposalType ProposalType, postID int, req UpdatePostRequest) (*Post, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
 	}
 
 	body := make(map[string]interface{})
@@ -308,8 +406,15 @@ func (c *Client) UpdatePost(proposalType string, postID int, req UpdatePostReque
 	if req.Content != "" {
 		body["content"] = req.Content
 	}
+	// A non-nil empty slice clears the tags
+	if req.Tags != nil {
+		body["tags"] = req.Tags
+	}
+	if req.TopicID > 0 {
+		body["topicId"] = req.TopicID
+	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(body).
 		Patch(fmt.Sprintf("/%s/%d", proposalType, postID))
 	if err != nil {
@@ -326,11 +431,19 @@ func (c *Client) UpdatePost(proposalType string, postID int, req UpdatePostReque
 
 // GetChildBounties retrieves child bounties for a parent bounty
 func (c *Client) GetChildBounties(bountyID int) ([]Bounty, error) {
-	r, err := c.client.R().
+	return c.GetChildBountiesCtx(context.Background(), bountyID)
+}
+
+// GetChildBountiesCtx is like GetChildBounties but carries ctx for cancellation and deadlines
+func (c *Client) GetChildBountiesCtx(ctx context.Context, bountyID int) ([]Bounty, error) {
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/Bounty/%d/child-bounties", bountyID))
 	if err != nil {
 		return nil, err
 	}
+	if err := c.checkResponse(r); err != nil {
+		return nil, err
+	}
 
 	var resp struct {
 		ChildBounties []Bounty `json:"child_bounties"`
@@ -343,12 +456,21 @@ func (c *Client) GetChildBounties(bountyID int) ([]Bounty, error) {
 }
 
 // GetPreimageForPost retrieves preimage for a specific post
-func (c *Client) GetPreimageForPost(proposalType string, postID int) (*Preimage, error) {
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+func (c *Client) GetPreimageForPost(proposalType ProposalType, postID int) (*Preimage, error) {
+	return c.GetPreimageForPostCtx(context.Background(), proposalType, postID)
+}
+
+// GetPreimageForPostCtx is like GetPreimageForPost but carries ctx for cancellation and deadlines
+func (c *Client) GetPreimageForPostCtx(ctx context.Context, proposalType ProposalType, postID int) (*Preimage, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+	if err := proposalType.requireCapability(proposalType.HasPreimage(), "preimage"); err != nil {
+		return nil, err
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/%s/%d/preimage", proposalType, postID))
 	if err != nil {
 		return nil, err
@@ -1,8 +1,15 @@
 package polkassembly
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 func (c *Client) GetPreimages(params PreimageListingParams) (*PreimageListingResponse, error) {
+	return c.GetPreimagesCtx(context.Background(), params)
+}
+
+func (c *Client) GetPreimagesCtx(ctx context.Context, params PreimageListingParams) (*PreimageListingResponse, error) {
 	queryParams := make(map[string]string)
 	if params.Page > 0 {
 		queryParams["page"] = fmt.Sprintf("%d", params.Page)
@@ -11,7 +18,7 @@ func (c *Client) GetPreimages(params PreimageListingParams) (*PreimageListingRes
 		queryParams["limit"] = fmt.Sprintf("%d", params.Limit)
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetQueryParams(queryParams).
 		Get("/preimages")
 	if err != nil {
@@ -27,7 +34,11 @@ func (c *Client) GetPreimages(params PreimageListingParams) (*PreimageListingRes
 }
 
 func (c *Client) GetPreimageByHash(hash string) (*Preimage, error) {
-	r, err := c.client.R().
+	return c.GetPreimageByHashCtx(context.Background(), hash)
+}
+
+func (c *Client) GetPreimageByHashCtx(ctx context.Context, hash string) (*Preimage, error) {
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/preimages/%s", hash))
 	if err != nil {
 		return nil, err
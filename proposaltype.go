@@ -0,0 +1,132 @@
+package polkassembly
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProposalType identifies a kind of Polkassembly post. It is the first path
+// segment of most endpoints, e.g. /ReferendumV2/123.
+type ProposalType string
+
+// Proposal types known to Polkassembly
+const (
+	ProposalTypeReferendumV2          ProposalType = "ReferendumV2"
+	ProposalTypeFellowshipReferendum  ProposalType = "FellowshipReferendum"
+	ProposalTypeReferendum            ProposalType = "Referendum"
+	ProposalTypeDemocracyProposal     ProposalType = "DemocracyProposal"
+	ProposalTypeCouncilMotion         ProposalType = "CouncilMotion"
+	ProposalTypeTechCommitteeProposal ProposalType = "TechCommitteeProposal"
+	ProposalTypeTreasuryProposal      ProposalType = "TreasuryProposal"
+	ProposalTypeBounty                ProposalType = "Bounty"
+	ProposalTypeChildBounty           ProposalType = "ChildBounty"
+	ProposalTypeTip                   ProposalType = "Tip"
+	ProposalTypeAllianceMotion        ProposalType = "AllianceMotion"
+	ProposalTypeAnnouncement          ProposalType = "Announcement"
+	ProposalTypeTechnicalPip          ProposalType = "TechnicalPip"
+	ProposalTypeUpgradePip            ProposalType = "UpgradePip"
+	ProposalTypeCommunityPip          ProposalType = "CommunityPip"
+	ProposalTypeAdvisoryCommittee     ProposalType = "AdvisoryCommittee"
+	ProposalTypeDiscussion            ProposalType = "Discussion"
+	ProposalTypeGrant                 ProposalType = "Grant"
+)
+
+// ProposalTypeInfo describes what the API offers for a proposal type
+type ProposalTypeInfo struct {
+	Type        ProposalType
+	OffChain    bool // Created and edited through the API rather than on-chain
+	HasVotes    bool // Supports the /votes endpoints
+	HasPreimage bool // Supports /preimage
+	HasCurves   bool // Supports /vote-curves (OpenGov approval and support)
+}
+
+var proposalTypes = map[ProposalType]ProposalTypeInfo{
+	ProposalTypeReferendumV2:          {HasVotes: true, HasPreimage: true, HasCurves: true},
+	ProposalTypeFellowshipReferendum:  {HasVotes: true, HasPreimage: true, HasCurves: true},
+	ProposalTypeReferendum:            {HasVotes: true, HasPreimage: true},
+	ProposalTypeDemocracyProposal:     {HasVotes: true, HasPreimage: true},
+	ProposalTypeCouncilMotion:         {HasVotes: true},
+	ProposalTypeTechCommitteeProposal: {HasVotes: true},
+	ProposalTypeTreasuryProposal:      {},
+	ProposalTypeBounty:                {},
+	ProposalTypeChildBounty:           {},
+	ProposalTypeTip:                   {},
+	ProposalTypeAllianceMotion:        {HasVotes: true},
+	ProposalTypeAnnouncement:          {},
+	ProposalTypeTechnicalPip:          {HasVotes: true},
+	ProposalTypeUpgradePip:            {HasVotes: true},
+	ProposalTypeCommunityPip:          {HasVotes: true},
+	ProposalTypeAdvisoryCommittee:     {HasVotes: true},
+	ProposalTypeDiscussion:            {OffChain: true},
+	ProposalTypeGrant:                 {OffChain: true},
+}
+
+// ProposalTypes returns every known proposal type in alphabetical order
+func ProposalTypes() []ProposalType {
+	types := make([]ProposalType, 0, len(proposalTypes))
+	for t := range proposalTypes {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// ParseProposalType maps a string to a known proposal type, ignoring case,
+// so "referendumv2" yields ProposalTypeReferendumV2. Unknown names return an
+// error matching ErrValidation.
+func ParseProposalType(s string) (ProposalType, error) {
+	for t := range proposalTypes {
+		if strings.EqualFold(string(t), s) {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unknown proposal type %q", ErrValidation, s)
+}
+
+// Valid reports whether p is a known proposal type. Matching is exact.
+func (p ProposalType) Valid() bool {
+	_, ok := proposalTypes[p]
+	return ok
+}
+
+// Info returns the capabilities of p. Unknown types have none.
+func (p ProposalType) Info() ProposalTypeInfo {
+	info := proposalTypes[p]
+	info.Type = p
+	return info
+}
+
+// IsOffChain reports whether posts of type p only exist on Polkassembly
+func (p ProposalType) IsOffChain() bool { return proposalTypes[p].OffChain }
+
+// HasVotes reports whether posts of type p can be voted on
+func (p ProposalType) HasVotes() bool { return proposalTypes[p].HasVotes }
+
+// HasPreimage reports whether posts of type p reference a preimage
+func (p ProposalType) HasPreimage() bool { return proposalTypes[p].HasPreimage }
+
+// HasCurves reports whether posts of type p have approval and support curves
+func (p ProposalType) HasCurves() bool { return proposalTypes[p].HasCurves }
+
+func (p ProposalType) String() string { return string(p) }
+
+// orDefault returns def when p is empty and rejects unknown types before a
+// request is sent, so a typo doesn't silently produce an empty listing
+func (p ProposalType) orDefault(def ProposalType) (ProposalType, error) {
+	if p == "" {
+		return def, nil
+	}
+	if !p.Valid() {
+		return "", fmt.Errorf("%w: unknown proposal type %q", ErrValidation, string(p))
+	}
+	return p, nil
+}
+
+// requireCapability returns an ErrValidation error when p lacks a capability
+func (p ProposalType) requireCapability(ok bool, what string) error {
+	if !ok {
+		return fmt.Errorf("%w: %s posts have no %s", ErrValidation, p, what)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package polkassembly
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParseProposalType(t *testing.T) {
+	for _, s := range []string{"ReferendumV2", "referendumv2", "REFERENDUMV2"} {
+		pt, err := ParseProposalType(s)
+		if err != nil || pt != ProposalTypeReferendumV2 {
+			t.Errorf("%q: got %q, %v", s, pt, err)
+		}
+	}
+
+	if _, err := ParseProposalType("Referenda"); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation, got %v", err)
+	}
+}
+
+func TestProposalTypeCapabilities(t *testing.T) {
+	if !ProposalTypeReferendumV2.HasVotes() || !ProposalTypeReferendumV2.HasCurves() || !ProposalTypeReferendumV2.HasPreimage() {
+		t.Errorf("ReferendumV2 should have votes, curves and preimage: %+v", ProposalTypeReferendumV2.Info())
+	}
+	if ProposalTypeDiscussion.HasVotes() || !ProposalTypeDiscussion.IsOffChain() {
+		t.Errorf("Discussion should be off-chain without votes: %+v", ProposalTypeDiscussion.Info())
+	}
+	if ProposalTypeBounty.HasCurves() {
+		t.Error("Bounty should have no curves")
+	}
+	for _, pt := range ProposalTypes() {
+		if !pt.Valid() || pt.Info().Type != pt {
+			t.Errorf("registry entry %q is inconsistent", pt)
+		}
+	}
+}
+
+func TestProposalTypeRejectedBeforeRequest(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+
+	if _, err := c.GetPosts(PostListingParams{ProposalType: "Referendumv2"}); !errors.Is(err, ErrValidation) {
+		t.Errorf("typo: expected ErrValidation, got %v", err)
+	}
+	if _, err := c.GetVotesByType(VoteListingParams{PostID: 1}, ProposalTypeDiscussion); !errors.Is(err, ErrValidation) {
+		t.Errorf("votes on Discussion: expected ErrValidation, got %v", err)
+	}
+	if _, err := c.CreateOffchainPost(ProposalTypeReferendumV2, CreateOffchainPostRequest{Title: "x"}); !errors.Is(err, ErrValidation) {
+		t.Errorf("off-chain ReferendumV2: expected ErrValidation, got %v", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 0 {
+		t.Errorf("expected no requests, got %d", n)
+	}
+}
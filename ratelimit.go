@@ -0,0 +1,109 @@
+package polkassembly
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RateLimiter throttles outgoing requests. Wait blocks until the request may
+// proceed or ctx is done. Implementations must be safe for concurrent use so
+// a single limiter can be shared by several Client instances.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is a RateLimiter that allows bursts of up to Burst requests
+// and refills at a steady rate.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket creates a limiter allowing rps requests per second with
+// bursts of up to burst requests. A non-positive rps disables limiting.
+func NewTokenBucket(rps float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if b.rate <= 0 {
+		return ctx.Err()
+	}
+
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	// Reserve a token up front; a negative balance queues later callers
+	// behind this one.
+	b.tokens--
+	if b.tokens >= 0 {
+		b.mu.Unlock()
+		return nil
+	}
+	wait := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	b.mu.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// installRateLimiters makes every request wait on the limiter matching its
+// method. Writes fall back to the read limiter when no write limiter is set.
+func installRateLimiters(rc *resty.Client, read, write RateLimiter) {
+	if write == nil {
+		write = read
+	}
+	if read == nil && write == nil {
+		return
+	}
+
+	rc.OnBeforeRequest(func(_ *resty.Client, r *resty.Request) error {
+		limiter := read
+		if !isReadMethod(r.Method) {
+			limiter = write
+		}
+		if limiter == nil {
+			return nil
+		}
+		return limiter.Wait(r.Context())
+	})
+}
+
+func isReadMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package polkassembly
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketBurstAndRefill(t *testing.T) {
+	b := NewTokenBucket(50, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := b.Wait(ctx); err != nil {
+			t.Fatalf("Wait failed: %v", err)
+		}
+	}
+	// Two requests fit in the burst, the remaining two wait ~20ms each
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Fatalf("expected throttling, finished in %v", elapsed)
+	}
+}
+
+func TestTokenBucketContextCancel(t *testing.T) {
+	b := NewTokenBucket(0.1, 1)
+	if err := b.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"users":[],"count":0}`))
+	}))
+	defer srv.Close()
+
+	shared := NewTokenBucket(100, 1)
+	clients := []*Client{
+		NewClient(Config{BaseURL: srv.URL, RateLimiter: shared}),
+		NewClient(Config{BaseURL: srv.URL, RateLimiter: shared}),
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func(c *Client) {
+			defer wg.Done()
+			if _, err := c.GetUsers(UserListingParams{}); err != nil {
+				t.Errorf("GetUsers failed: %v", err)
+			}
+		}(clients[i%2])
+	}
+	wg.Wait()
+
+	// Six requests at 100 req/s with burst 1 need at least ~50ms
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Fatalf("shared budget not enforced, finished in %v", elapsed)
+	}
+}
@@ -0,0 +1,205 @@
+package polkassembly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultRefreshBefore is how long before expiry a token is refreshed when
+// Config.RefreshBefore is zero
+const DefaultRefreshBefore = time.Minute
+
+// ReauthFunc obtains a fresh token for c, typically by logging in again and
+// letting the login method store the new token. It is called when the
+// current token is about to expire or is rejected with 401.
+type ReauthFunc func(ctx context.Context, c *Client) error
+
+// ReauthWithSigner returns a ReauthFunc that signs in again with signer
+func ReauthWithSigner(network string, signer Signer) ReauthFunc {
+	return func(ctx context.Context, c *Client) error {
+		return c.AuthenticateWithSignerCtx(ctx, network, signer)
+	}
+}
+
+// SetReauth sets the hook used to re-authenticate. Nil disables
+// re-authentication.
+func (c *Client) SetReauth(fn ReauthFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reauth = fn
+}
+
+// Reauthenticate runs the re-auth hook now
+func (c *Client) Reauthenticate(ctx context.Context) error {
+	c.mu.RLock()
+	token := c.token
+	c.mu.RUnlock()
+	_, err := c.refreshToken(ctx, token)
+	return err
+}
+
+var errNoReauth = errors.New("polkassembly: no re-auth hook")
+
+type reauthKey struct{}
+
+// requestAuth identifies the client and token a request was built with
+type requestAuth struct {
+	client *Client
+	token  string
+}
+
+type requestAuthKey struct{}
+
+// refreshToken runs the re-auth hook and returns the new token. Concurrent
+// callers holding the same stale token share one re-authentication.
+func (c *Client) refreshToken(ctx context.Context, stale string) (string, error) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	c.mu.RLock()
+	token, reauth := c.token, c.reauth
+	c.mu.RUnlock()
+	if token != stale {
+		return token, nil
+	}
+	if reauth == nil {
+		return "", errNoReauth
+	}
+
+	// Requests made by the hook itself must not trigger another refresh
+	if err := reauth(context.WithValue(ctx, reauthKey{}, true), c); err != nil {
+		return "", fmt.Errorf("re-authenticate: %w", err)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.token, nil
+}
+
+// expiresSoon reports whether token expires within the refresh window
+func (c *Client) expiresSoon(token string) bool {
+	if c.refreshBefore < 0 || token == "" {
+		return false
+	}
+	claims, err := ParseToken(token)
+	if err != nil || claims.Expiry.IsZero() {
+		return false
+	}
+	return time.Until(claims.Expiry) < c.refreshBefore
+}
+
+// reauthTransport refreshes tokens that are about to expire before sending
+// a request, and on 401 re-authenticates and replays the request once with
+// the new credentials. It sits below resty so that every endpoint gets this
+// without changes, and so that resty's retries and rate limiting see a
+// single request.
+type reauthTransport struct {
+	base http.RoundTripper
+}
+
+func (t *reauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	auth, ok := ctx.Value(requestAuthKey{}).(requestAuth)
+	// Login endpoints answer 401 for bad credentials; there is nothing to
+	// refresh there
+	if !ok || ctx.Value(reauthKey{}) != nil || isLoginPath(req.URL.Path) {
+		return t.base.RoundTrip(req)
+	}
+	c := auth.client
+	c.mu.RLock()
+	enabled := c.reauth != nil
+	c.mu.RUnlock()
+	if !enabled {
+		return t.base.RoundTrip(req)
+	}
+
+	token := auth.token
+	if c.expiresSoon(token) {
+		fresh, err := c.refreshToken(ctx, token)
+		if err != nil {
+			c.logDebug("Refreshing token before expiry failed: %v", err)
+		} else if fresh != token {
+			token = fresh
+			req = c.withCredentials(req, token)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body was consumed and cannot be sent again
+		return resp, nil
+	}
+
+	fresh, err := c.refreshToken(ctx, token)
+	if err != nil || fresh == token {
+		if err != nil {
+			c.logDebug("Re-authentication after 401 failed: %v", err)
+		}
+		return resp, nil
+	}
+
+	replay := c.withCredentials(req, fresh)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		replay.Body = body
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	c.logDebug("Replaying %s %s after re-authentication", req.Method, req.URL)
+	return t.base.RoundTrip(replay)
+}
+
+// loginPaths are the endpoints that obtain credentials rather than use them.
+// Other /auth/ endpoints, such as sessions and logout, need a valid token.
+var loginPaths = []string{
+	"/auth/web3-auth",
+	"/auth/web2-auth/login",
+	"/auth/web2-auth/signup",
+	"/auth/send-reset-password-email",
+	"/auth/reset-password-with-token",
+	"/auth/qr-session",
+}
+
+// isLoginPath reports whether path is a login endpoint. The base URL may
+// carry a path prefix, so only the end of path is compared.
+func isLoginPath(path string) bool {
+	path = strings.TrimRight(path, "/")
+	for _, p := range loginPaths {
+		if strings.HasSuffix(path, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// withCredentials returns a copy of req carrying token and the client's
+// current cookies
+func (c *Client) withCredentials(req *http.Request, token string) *http.Request {
+	c.mu.RLock()
+	cookies := c.cookies
+	c.mu.RUnlock()
+
+	r := req.Clone(req.Context())
+	if token != "" {
+		r.Header.Set("Authorization", authorizationHeader(token))
+	} else {
+		r.Header.Del("Authorization")
+	}
+	r.Header.Del("Cookie")
+	for _, cookie := range cookies {
+		r.AddCookie(cookie)
+	}
+	return r
+}
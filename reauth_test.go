@@ -0,0 +1,100 @@
+package polkassembly
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReauthReplaysRejectedRequest(t *testing.T) {
+	valid := testJWT(map[string]interface{}{"sub": "1", "exp": time.Now().Add(time.Hour).Unix()})
+
+	var calls, rejected int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("Authorization") != "Bearer "+valid {
+			atomic.AddInt32(&rejected, 1)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if r.Method == http.MethodPost && string(body) != `{"content":"hi"}` {
+			t.Errorf("replayed body: %s", body)
+		}
+		w.Write([]byte(`{"id":"c1"}`))
+	}))
+	defer srv.Close()
+
+	var reauths int32
+	c := NewClient(Config{
+		BaseURL: srv.URL,
+		Network: "polkadot",
+		Token:   "revoked",
+		Reauth: func(ctx context.Context, c *Client) error {
+			atomic.AddInt32(&reauths, 1)
+			return c.SetAuthToken(valid)
+		},
+	})
+
+	if _, err := c.AddComment(ProposalTypeReferendumV2, 1, AddCommentRequest{Content: "hi"}); err != nil {
+		t.Fatal(err)
+	}
+	if reauths != 1 || rejected != 1 || calls != 2 {
+		t.Errorf("got %d re-auths, %d rejected of %d calls", reauths, rejected, calls)
+	}
+
+	// A hook that does not produce a new token leaves the 401 in place
+	c.SetReauth(func(context.Context, *Client) error { return nil })
+	c.SetAuthToken("revoked")
+	if _, err := c.AddComment(ProposalTypeReferendumV2, 1, AddCommentRequest{Content: "hi"}); err == nil {
+		t.Error("expected 401 without a new token")
+	}
+}
+
+func TestReauthRefreshesBeforeExpiry(t *testing.T) {
+	expiring := testJWT(map[string]interface{}{"sub": "1", "exp": time.Now().Add(10 * time.Second).Unix()})
+	fresh := testJWT(map[string]interface{}{"sub": "1", "exp": time.Now().Add(time.Hour).Unix()})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+fresh {
+			t.Errorf("request sent with stale token")
+		}
+		w.Write([]byte(`{"id":"c1"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{
+		BaseURL: srv.URL,
+		Network: "polkadot",
+		Token:   expiring,
+		Reauth: func(ctx context.Context, c *Client) error {
+			return c.SetAuthToken(fresh)
+		},
+	})
+
+	if _, err := c.AddComment(ProposalTypeReferendumV2, 1, AddCommentRequest{Content: "hi"}); err != nil {
+		t.Fatal(err)
+	}
+	if claims, _ := c.TokenClaims(); claims.Expired(time.Now().Add(30 * time.Minute)) {
+		t.Error("token was not refreshed")
+	}
+}
+
+func TestIsLoginPath(t *testing.T) {
+	for path, want := range map[string]bool{
+		"/auth/web3-auth":              true,
+		"/api/v2/auth/web2-auth/login": true,
+		"/auth/qr-session/":            true,
+		"/auth/sessions":               false,
+		"/api/v2/auth/logout":          false,
+		"/users/1":                     false,
+	} {
+		if got := isLoginPath(path); got != want {
+			t.Errorf("isLoginPath(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package polkassembly
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Report content types
+const (
+	ReportTypePost    = "post"
+	ReportTypeComment = "comment"
+)
+
+// Report statuses
+const (
+	ReportStatusPending   = "pending"
+	ReportStatusResolved  = "resolved"
+	ReportStatusDismissed = "dismissed"
+)
+
+// CreateReport files a report against a post or comment
+func (c *Client) CreateReport(req CreateReportRequest) (*Report, error) {
+	return c.CreateReportCtx(context.Background(), req)
+}
+
+// CreateReportCtx is like CreateReport but carries ctx for cancellation and deadlines
+func (c *Client) CreateReportCtx(ctx context.Context, req CreateReportRequest) (*Report, error) {
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, fmt.Errorf("%w: report reason is required", ErrValidation)
+	}
+	switch req.Type {
+	case ReportTypePost:
+	case ReportTypeComment:
+		if req.CommentID == "" {
+			return nil, fmt.Errorf("%w: comment reports need a comment ID", ErrValidation)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown report type %q", ErrValidation, req.Type)
+	}
+	proposalType, err := req.ProposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+	req.ProposalType = proposalType
+
+	r, err := c.newRequest(ctx).
+		SetBody(req).
+		Post("/reports")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp Report
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ReportPost reports a post with the given reason
+func (c *Client) ReportPost(proposalType ProposalType, postID int, reason, comments string) (*Report, error) {
+	return c.ReportPostCtx(context.Background(), proposalType, postID, reason, comments)
+}
+
+// ReportPostCtx is like ReportPost but carries ctx for cancellation and deadlines
+func (c *Client) ReportPostCtx(ctx context.Context, proposalType ProposalType, postID int, reason, comments string) (*Report, error) {
+	return c.CreateReportCtx(ctx, CreateReportRequest{
+		Type:         ReportTypePost,
+		ContentID:    postID,
+		ProposalType: proposalType,
+		Reason:       reason,
+		Comments:     comments,
+	})
+}
+
+// ReportComment reports a comment on a post, e.g. one found via GetPostComments
+func (c *Client) ReportComment(proposalType ProposalType, postID int, commentID, reason, comments string) (*Report, error) {
+	return c.ReportCommentCtx(context.Background(), proposalType, postID, commentID, reason, comments)
+}
+
+// ReportCommentCtx is like ReportComment but carries ctx for cancellation and deadlines
+func (c *Client) ReportCommentCtx(ctx context.Context, proposalType ProposalType, postID int, commentID, reason, comments string) (*Report, error) {
+	return c.CreateReportCtx(ctx, CreateReportRequest{
+		Type:         ReportTypeComment,
+		ContentID:    postID,
+		CommentID:    commentID,
+		ProposalType: proposalType,
+		Reason:       reason,
+		Comments:     comments,
+	})
+}
+
+// GetMyReports lists the reports filed by the authenticated user
+func (c *Client) GetMyReports(params ReportListingParams) (*ReportListingResponse, error) {
+	return c.GetMyReportsCtx(context.Background(), params)
+}
+
+// GetMyReportsCtx is like GetMyReports but carries ctx for cancellation and deadlines
+func (c *Client) GetMyReportsCtx(ctx context.Context, params ReportListingParams) (*ReportListingResponse, error) {
+	return c.listReports(ctx, "/reports/me", params)
+}
+
+// GetReports lists all reports. It requires a moderator token; other
+// tokens get an error matching ErrUnauthorized.
+func (c *Client) GetReports(params ReportListingParams) (*ReportListingResponse, error) {
+	return c.GetReportsCtx(context.Background(), params)
+}
+
+// GetReportsCtx is like GetReports but carries ctx for cancellation and deadlines
+func (c *Client) GetReportsCtx(ctx context.Context, params ReportListingParams) (*ReportListingResponse, error) {
+	return c.listReports(ctx, "/reports", params)
+}
+
+func (c *Client) listReports(ctx context.Context, endpoint string, params ReportListingParams) (*ReportListingResponse, error) {
+	queryParams := make(map[string]string)
+	if params.Page > 0 {
+		queryParams["page"] = fmt.Sprintf("%d", params.Page)
+	}
+	if params.Limit > 0 {
+		queryParams["limit"] = fmt.Sprintf("%d", params.Limit)
+	}
+	if params.Status != "" {
+		queryParams["status"] = params.Status
+	}
+	if params.Type != "" {
+		queryParams["type"] = params.Type
+	}
+
+	r, err := c.newRequest(ctx).
+		SetQueryParams(queryParams).
+		Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ReportListingResponse
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ResolveReport resolves or dismisses a report. It requires a moderator token.
+func (c *Client) ResolveReport(reportID int, req ResolveReportRequest) (*Report, error) {
+	return c.ResolveReportCtx(context.Background(), reportID, req)
+}
+
+// ResolveReportCtx is like ResolveReport but carries ctx for cancellation and deadlines
+func (c *Client) ResolveReportCtx(ctx context.Context, reportID int, req ResolveReportRequest) (*Report, error) {
+	switch req.Status {
+	case ReportStatusResolved, ReportStatusDismissed:
+	default:
+		return nil, fmt.Errorf("%w: unknown resolution status %q", ErrValidation, req.Status)
+	}
+
+	r, err := c.newRequest(ctx).
+		SetBody(req).
+		Patch(fmt.Sprintf("/reports/%d", reportID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp Report
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,60 @@
+package polkassembly
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCreateReportValidation(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+	invalid := []CreateReportRequest{
+		{Type: ReportTypePost, ContentID: 1, Reason: "  "},
+		{Type: ReportTypeComment, ContentID: 1, Reason: "spam"},
+		{Type: "user", ContentID: 1, Reason: "spam"},
+		{Type: ReportTypePost, ContentID: 1, Reason: "spam", ProposalType: "NotAType"},
+	}
+	for _, req := range invalid {
+		if _, err := c.CreateReport(req); !errors.Is(err, ErrValidation) {
+			t.Errorf("%+v: expected ErrValidation, got %v", req, err)
+		}
+	}
+	if calls != 0 {
+		t.Errorf("invalid reports reached the server %d times", calls)
+	}
+}
+
+func TestReportComment(t *testing.T) {
+	var got CreateReportRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/reports" {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&got)
+		w.Write([]byte(`{"id":5,"status":"pending"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+	report, err := c.ReportComment("", 42, "c-9", "spam", "link farm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.ID != 5 || report.Status != ReportStatusPending {
+		t.Errorf("unexpected report %+v", report)
+	}
+	want := CreateReportRequest{Type: ReportTypeComment, ContentID: 42, CommentID: "c-9",
+		ProposalType: ProposalTypeReferendumV2, Reason: "spam", Comments: "link farm"}
+	if got != want {
+		t.Errorf("sent %+v, want %+v", got, want)
+	}
+}
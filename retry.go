@@ -0,0 +1,182 @@
+package polkassembly
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy controls how failed requests are retried.
+//
+// Only methods listed in Methods are retried. Writes such as POST and PATCH
+// are never retried unless RetryNonIdempotent is set, since replaying them
+// may create duplicate comments, reactions or cart items.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	// Values of 1 or less disable retries.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps every wait, including waits requested via Retry-After.
+	MaxBackoff time.Duration
+	// Multiplier grows the backoff after each attempt.
+	Multiplier float64
+	// Jitter is the fraction (0-1) of each backoff that is randomized.
+	Jitter float64
+	// StatusCodes lists the HTTP status codes that trigger a retry.
+	StatusCodes []int
+	// Methods lists the HTTP methods considered safe to retry.
+	Methods []string
+	// RetryNonIdempotent allows retrying methods not listed in Methods.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns the policy used when Config.Retry is nil
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		Methods: []string{
+			http.MethodGet,
+			http.MethodHead,
+			http.MethodOptions,
+			http.MethodPut,
+			http.MethodDelete,
+		},
+	}
+}
+
+// apply installs the policy on the underlying resty client
+func (p *RetryPolicy) apply(rc *resty.Client, logDebug func(string, ...interface{})) {
+	if p.MaxAttempts <= 1 {
+		rc.SetRetryCount(0)
+		return
+	}
+
+	rc.SetRetryCount(p.MaxAttempts - 1).
+		SetRetryWaitTime(0).
+		SetRetryMaxWaitTime(p.MaxBackoff).
+		SetRetryAfter(func(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+			return p.backoff(resp), nil
+		}).
+		AddRetryCondition(p.shouldRetry).
+		AddRetryHook(func(resp *resty.Response, err error) {
+			if resp == nil || resp.Request == nil {
+				return
+			}
+			logDebug("Retrying %s %s after attempt %d (status %d, err %v)",
+				resp.Request.Method, resp.Request.URL, resp.Request.Attempt, resp.StatusCode(), err)
+		})
+}
+
+func (p *RetryPolicy) shouldRetry(resp *resty.Response, err error) bool {
+	if resp == nil || resp.Request == nil {
+		return false
+	}
+	if resp.Request.Context().Err() != nil {
+		return false
+	}
+	if !p.allowsMethod(resp.Request.Method) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	for _, code := range p.StatusCodes {
+		if resp.StatusCode() == code {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) allowsMethod(method string) bool {
+	if p.RetryNonIdempotent {
+		return true
+	}
+	for _, m := range p.Methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the wait before the next attempt. A Retry-After header on
+// the response takes precedence over the exponential curve.
+func (p *RetryPolicy) backoff(resp *resty.Response) time.Duration {
+	attempt := 1
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header().Get("Retry-After"), time.Now()); ok {
+			return p.clamp(d)
+		}
+		if resp.Request != nil && resp.Request.Attempt > 0 {
+			attempt = resp.Request.Attempt
+		}
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	wait := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		wait -= wait * jitter * rand.Float64()
+	}
+
+	return p.clamp(time.Duration(wait))
+}
+
+func (p *RetryPolicy) clamp(d time.Duration) time.Duration {
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	// resty treats a zero wait as "use the default curve"
+	if d <= 0 {
+		d = time.Nanosecond
+	}
+	return d
+}
+
+// parseRetryAfter understands both forms of the Retry-After header:
+// delay-seconds and an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		d := t.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
@@ -0,0 +1,87 @@
+package polkassembly
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.InitialBackoff = time.Millisecond
+	p.MaxBackoff = 10 * time.Millisecond
+	return p
+}
+
+func TestRetryIdempotentRequest(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"items":[{"index":1,"title":"ok"}],"totalCount":1}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot", Retry: testRetryPolicy()})
+
+	resp, err := c.GetPosts(PostListingParams{Page: 1})
+	if err != nil {
+		t.Fatalf("GetPosts failed: %v", err)
+	}
+	if len(resp.Posts) != 1 {
+		t.Fatalf("expected 1 post, got %d", len(resp.Posts))
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestRetrySkipsNonIdempotentWrites(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot", Retry: testRetryPolicy()})
+
+	if _, err := c.AddComment("ReferendumV2", 1, AddCommentRequest{Content: "hi"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("expected a single attempt for POST, got %d", got)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	p := testRetryPolicy()
+	p.RetryNonIdempotent = true
+	c = NewClient(Config{BaseURL: srv.URL, Network: "polkadot", Retry: p})
+
+	if _, err := c.AddComment("ReferendumV2", 1, AddCommentRequest{Content: "hi"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := atomic.LoadInt32(&calls); got != int32(p.MaxAttempts) {
+		t.Fatalf("expected %d attempts with opt-in, got %d", p.MaxAttempts, got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if d, ok := parseRetryAfter("7", now); !ok || d != 7*time.Second {
+		t.Errorf("seconds form: got %v, %v", d, ok)
+	}
+	date := now.Add(90 * time.Second).Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(date, now); !ok || d != 90*time.Second {
+		t.Errorf("date form: got %v, %v", d, ok)
+	}
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Error("expected invalid value to be rejected")
+	}
+}
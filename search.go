@@ -0,0 +1,137 @@
+package polkassembly
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+)
+
+// Search runs a full-text search across posts, comments and users
+func (c *Client) Search(params SearchParams) (*SearchResponse, error) {
+	return c.SearchCtx(context.Background(), params)
+}
+
+// SearchCtx is like Search but carries ctx for cancellation and deadlines
+func (c *Client) SearchCtx(ctx context.Context, params SearchParams) (*SearchResponse, error) {
+	queryParams, err := searchQueryParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Searching another network goes through a derived client so the
+	// request reaches that network's API
+	client := c
+	if params.Network != "" && params.Network != c.Network() {
+		client = c.WithNetwork(params.Network)
+	}
+
+	r, err := client.newRequest(ctx).
+		SetQueryParams(queryParams).
+		Get("/search")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp SearchResponse
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	for i := range resp.Posts {
+		if resp.Posts[i].PostID == 0 && resp.Posts[i].Index > 0 {
+			resp.Posts[i].PostID = resp.Posts[i].Index
+		}
+	}
+	if resp.Page == 0 {
+		resp.Page = params.Page
+	}
+	if resp.Limit == 0 {
+		resp.Limit = params.Limit
+	}
+
+	return &resp, nil
+}
+
+// SearchAll iterates over every search hit, fetching pages on demand.
+// params.Page is ignored and params.Limit is used as the page size unless
+// opts.PageSize is set.
+func (c *Client) SearchAll(ctx context.Context, params SearchParams, opts PageOptions) iter.Seq2[SearchResult, error] {
+	size := opts.pageSize(params.Limit)
+	return paginate(ctx, size, opts, func(ctx context.Context, page, limit int) ([]SearchResult, int, error) {
+		p := params
+		p.Page, p.Limit = page, limit
+		resp, err := c.SearchCtx(ctx, p)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Results(), resp.TotalCount, nil
+	})
+}
+
+// Results flattens the response into a single list of hits
+func (r *SearchResponse) Results() []SearchResult {
+	results := make([]SearchResult, 0, len(r.Posts)+len(r.Comments)+len(r.Users))
+	for i := range r.Posts {
+		results = append(results, SearchResult{Type: "post", Post: &r.Posts[i]})
+	}
+	for i := range r.Comments {
+		results = append(results, SearchResult{Type: "comment", Comment: &r.Comments[i]})
+	}
+	for i := range r.Users {
+		results = append(results, SearchResult{Type: "user", User: &r.Users[i]})
+	}
+	return results
+}
+
+func searchQueryParams(params SearchParams) (map[string]string, error) {
+	query := strings.TrimSpace(params.Query)
+	if query == "" {
+		return nil, fmt.Errorf("%w: search query is required", ErrValidation)
+	}
+
+	switch params.Type {
+	case "", "all", "posts", "comments", "users":
+	default:
+		return nil, fmt.Errorf("%w: unknown search type %q", ErrValidation, params.Type)
+	}
+
+	if !params.DateFrom.IsZero() && !params.DateTo.IsZero() && params.DateTo.Before(params.DateFrom) {
+		return nil, fmt.Errorf("%w: date range ends before it starts", ErrValidation)
+	}
+
+	queryParams := map[string]string{"query": query}
+	if params.Type != "" {
+		queryParams["type"] = params.Type
+	}
+	if params.Network != "" {
+		queryParams["network"] = params.Network
+	}
+	if params.Author != "" {
+		queryParams["author"] = params.Author
+	}
+	if len(params.Tags) > 0 {
+		queryParams["tags"] = strings.Join(params.Tags, ",")
+	}
+	if !params.DateFrom.IsZero() {
+		queryParams["dateFrom"] = params.DateFrom.UTC().Format(time.RFC3339)
+	}
+	if !params.DateTo.IsZero() {
+		queryParams["dateTo"] = params.DateTo.UTC().Format(time.RFC3339)
+	}
+	if params.Status != "" {
+		queryParams["status"] = params.Status
+	}
+	if params.TrackNo > 0 {
+		queryParams["trackNo"] = fmt.Sprintf("%d", params.TrackNo)
+	}
+	if params.Page > 0 {
+		queryParams["page"] = fmt.Sprintf("%d", params.Page)
+	}
+	if params.Limit > 0 {
+		queryParams["limit"] = fmt.Sprintf("%d", params.Limit)
+	}
+
+	return queryParams, nil
+}
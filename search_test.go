@@ -0,0 +1,91 @@
+package polkassembly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSearchQueryParams(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.FixedZone("CET", 3600))
+	q, err := searchQueryParams(SearchParams{
+		Query:    "  treasury  ",
+		Type:     "posts",
+		Tags:     []string{"defi", "grants"},
+		DateFrom: from,
+		TrackNo:  33,
+		Page:     2,
+		Limit:    20,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"query": "treasury", "type": "posts", "tags": "defi,grants",
+		"dateFrom": "2025-12-31T23:00:00Z", "trackNo": "33", "page": "2", "limit": "20",
+	}
+	if len(q) != len(want) {
+		t.Errorf("got %v, want %v", q, want)
+	}
+	for k, v := range want {
+		if q[k] != v {
+			t.Errorf("%s: got %q, want %q", k, q[k], v)
+		}
+	}
+
+	invalid := []SearchParams{
+		{Query: "   "},
+		{Query: "x", Type: "bounties"},
+		{Query: "x", DateFrom: from, DateTo: from.Add(-time.Hour)},
+	}
+	for _, p := range invalid {
+		if _, err := searchQueryParams(p); !errors.Is(err, ErrValidation) {
+			t.Errorf("%+v: expected ErrValidation, got %v", p, err)
+		}
+	}
+}
+
+func TestSearchAll(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("x-network") != "kusama" {
+			t.Errorf("search sent to network %q", r.Header.Get("x-network"))
+		}
+		var resp SearchResponse
+		resp.TotalCount = 3
+		switch page, _ := strconv.Atoi(r.URL.Query().Get("page")); page {
+		case 1:
+			resp.Posts = []Post{{Index: 5}}
+			resp.Users = []User{{Username: "alice"}}
+		case 2:
+			resp.Comments = []Comment{{ID: "c1"}}
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+	var types []string
+	for hit, err := range c.SearchAll(context.Background(), SearchParams{Query: "x", Network: "kusama"}, PageOptions{PageSize: 2}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		types = append(types, hit.Type)
+		if hit.Type == "post" && hit.Post.PostID != 5 {
+			t.Errorf("post ID not filled from index: %+v", hit.Post)
+		}
+	}
+	if len(types) != 3 || types[0] != "post" || types[1] != "user" || types[2] != "comment" {
+		t.Errorf("got %v", types)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 requests, got %d", calls)
+	}
+}
@@ -0,0 +1,122 @@
+package polkassembly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Session is a login of the authenticated user on some device
+type Session struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"userAgent,omitempty"`
+	IP         string    `json:"ip,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt,omitempty"`
+	ExpiresAt  time.Time `json:"expiresAt,omitempty"`
+	// Current marks the session of the token this client uses
+	Current bool `json:"isCurrent,omitempty"`
+}
+
+// Logout invalidates the token server-side and clears the client's token,
+// cookies, re-auth hook and TokenStorage. Local state is cleared even when
+// the server call fails; a token the server already rejects counts as
+// logged out.
+func (c *Client) Logout() error {
+	return c.LogoutCtx(context.Background())
+}
+
+// LogoutCtx is like Logout but carries ctx for cancellation and deadlines
+func (c *Client) LogoutCtx(ctx context.Context) error {
+	var serverErr error
+	r, err := c.newRequest(ctx).
+		Post("/auth/logout")
+	if err != nil {
+		serverErr = err
+	} else if err := c.checkResponse(r); err != nil && !errors.Is(err, ErrUnauthorized) {
+		serverErr = err
+	}
+
+	return errors.Join(serverErr, c.clearSession())
+}
+
+// clearSession forgets every credential of the client
+func (c *Client) clearSession() error {
+	c.mu.Lock()
+	c.token = ""
+	c.cookies = nil
+	c.reauth = nil
+	storage := c.tokenStorage
+	c.mu.Unlock()
+
+	if storage != nil {
+		if err := storage.DeleteToken(); err != nil {
+			return fmt.Errorf("delete token: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetSessions lists the active sessions of the authenticated user
+func (c *Client) GetSessions() ([]Session, error) {
+	return c.GetSessionsCtx(context.Background())
+}
+
+// GetSessionsCtx is like GetSessions but carries ctx for cancellation and deadlines
+func (c *Client) GetSessionsCtx(ctx context.Context) ([]Session, error) {
+	r, err := c.newRequest(ctx).
+		Get("/auth/sessions")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Sessions []Session `json:"sessions"`
+	}
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Sessions, nil
+}
+
+// RevokeSession ends one session of the authenticated user, e.g. a lost
+// device. Revoking the current session does not clear the client; use
+// Logout for that.
+func (c *Client) RevokeSession(sessionID string) error {
+	return c.RevokeSessionCtx(context.Background(), sessionID)
+}
+
+// RevokeSessionCtx is like RevokeSession but carries ctx for cancellation and deadlines
+func (c *Client) RevokeSessionCtx(ctx context.Context, sessionID string) error {
+	if sessionID == "" {
+		return fmt.Errorf("%w: session ID is required", ErrValidation)
+	}
+
+	r, err := c.newRequest(ctx).
+		Delete("/auth/sessions/" + url.PathEscape(sessionID))
+	if err != nil {
+		return err
+	}
+
+	return c.checkResponse(r)
+}
+
+// RevokeOtherSessions ends every session of the authenticated user except
+// the current one
+func (c *Client) RevokeOtherSessions() error {
+	return c.RevokeOtherSessionsCtx(context.Background())
+}
+
+// RevokeOtherSessionsCtx is like RevokeOtherSessions but carries ctx for cancellation and deadlines
+func (c *Client) RevokeOtherSessionsCtx(ctx context.Context) error {
+	r, err := c.newRequest(ctx).
+		SetQueryParam("keepCurrent", "true").
+		Delete("/auth/sessions")
+	if err != nil {
+		return err
+	}
+
+	return c.checkResponse(r)
+}
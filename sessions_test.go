@@ -0,0 +1,112 @@
+package polkassembly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLogoutClearsCredentials(t *testing.T) {
+	var sawAuth []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/logout" {
+			sawAuth = append(sawAuth, r.Header.Get("Authorization"))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get("Authorization") != "" || r.Header.Get("Cookie") != "" {
+			t.Errorf("credentials sent after logout: %v", r.Header)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	storage := &MemoryTokenStorage{}
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot", Token: "a.b.c", TokenStorage: storage})
+	c.setCookie(&http.Cookie{Name: "access_token", Value: "a.b.c"})
+	c.SetReauth(func(context.Context, *Client) error { return c.SetAuthToken("x.y.z") })
+
+	// A rejected token is refreshed and logout replayed; the server
+	// rejecting that too is treated as already logged out
+	if err := c.Logout(); err != nil {
+		t.Fatal(err)
+	}
+	if len(sawAuth) != 2 || sawAuth[0] != "Bearer a.b.c" || sawAuth[1] != "Bearer x.y.z" {
+		t.Errorf("logout sent %q", sawAuth)
+	}
+	if tok, _ := storage.GetToken(); tok != "" {
+		t.Errorf("token left in storage: %q", tok)
+	}
+	if _, err := c.GetUserByID(1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSessions(t *testing.T) {
+	var revoked []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/sessions":
+			w.Write([]byte(`{"sessions":[{"id":"s1","userAgent":"cli","createdAt":"2026-01-01T00:00:00Z","isCurrent":true},{"id":"s/2"}]}`))
+		case r.Method == http.MethodDelete:
+			revoked = append(revoked, r.URL.EscapedPath()+"?"+r.URL.RawQuery)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot", Token: "tok"})
+	sessions, err := c.GetSessions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 2 || !sessions[0].Current || sessions[0].UserAgent != "cli" || sessions[1].Current {
+		t.Fatalf("unexpected sessions %+v", sessions)
+	}
+
+	if err := c.RevokeSession(sessions[1].ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RevokeOtherSessions(); err != nil {
+		t.Fatal(err)
+	}
+	if len(revoked) != 2 || revoked[0] != "/auth/sessions/s%2F2?" || revoked[1] != "/auth/sessions?keepCurrent=true" {
+		t.Errorf("unexpected revocations %v", revoked)
+	}
+}
+
+func TestGetSessionsReauthenticatesExpiredToken(t *testing.T) {
+	fresh := testJWT(map[string]interface{}{"id": 1, "exp": time.Now().Add(time.Hour).Unix()})
+	var reauths int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+fresh {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"sessions":[{"id":"s1","isCurrent":true}]}`))
+	}))
+	defer srv.Close()
+
+	expired := testJWT(map[string]interface{}{"id": 1, "exp": time.Now().Add(-time.Hour).Unix()})
+	c := NewClient(Config{
+		BaseURL: srv.URL + "/api/v2",
+		Network: "polkadot",
+		Token:   expired,
+		Reauth: func(ctx context.Context, c *Client) error {
+			reauths++
+			return c.SetAuthToken(fresh)
+		},
+	})
+
+	sessions, err := c.GetSessions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reauths != 1 || len(sessions) != 1 || sessions[0].ID != "s1" {
+		t.Errorf("reauths = %d, sessions = %+v", reauths, sessions)
+	}
+}
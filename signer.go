@@ -6,6 +6,8 @@ import (
 
 	"github.com/ChainSafe/go-schnorrkel"
 	"github.com/vedhavyas/go-subkey/v2"
+	"github.com/vedhavyas/go-subkey/v2/ecdsa"
+	"github.com/vedhavyas/go-subkey/v2/ed25519"
 	"github.com/vedhavyas/go-subkey/v2/sr25519"
 )
 
@@ -15,6 +17,44 @@ type Signer interface {
 	Address() string
 }
 
+// WalletSigner is implemented by signers that know the wallet their
+// accounts belong to. AuthenticateWithSigner sends it as
+// Web3AuthRequest.Wallet.
+type WalletSigner interface {
+	Signer
+	Wallet() string
+}
+
+// KeyScheme is the signature scheme of a Substrate account
+type KeyScheme string
+
+const (
+	KeySchemeSr25519 KeyScheme = "sr25519"
+	KeySchemeEd25519 KeyScheme = "ed25519"
+	KeySchemeEcdsa   KeyScheme = "ecdsa"
+)
+
+// WalletPolkadotJS is the wallet of keys held in a polkadot-js style
+// keyring, which covers all three key schemes. Signers send it whatever
+// their scheme: the API verifies Substrate signatures with polkadot-js'
+// signatureVerify, which detects the scheme from the signature, so the
+// wallet only tells Substrate keyrings apart from Ethereum wallets.
+const WalletPolkadotJS = "polkadot-js"
+
+// NewSignerFromSeed creates a signer for the given key scheme from a seed
+// phrase, hex seed or derivation URI such as "//Alice"
+func NewSignerFromSeed(seedPhrase string, network uint16, scheme KeyScheme) (Signer, error) {
+	switch scheme {
+	case KeySchemeSr25519, "":
+		return NewPolkadotSignerFromSeed(seedPhrase, network)
+	case KeySchemeEd25519:
+		return NewEd25519SignerFromSeed(seedPhrase, network)
+	case KeySchemeEcdsa:
+		return NewEcdsaSignerFromSeed(seedPhrase, network)
+	}
+	return nil, fmt.Errorf("%w: unknown key scheme %q", ErrValidation, scheme)
+}
+
 // PolkadotSigner implements the Signer interface for Polkadot accounts
 type PolkadotSigner struct {
 	privateKey *schnorrkel.SecretKey
@@ -53,17 +93,7 @@ func NewPolkadotSignerFromSeed(seedPhrase string, network uint16) (*PolkadotSign
 		return nil, fmt.Errorf("get public key: %w", err)
 	}
 
-	var ss58Format uint16
-	switch network {
-	case 0: // Polkadot
-		ss58Format = 0
-	case 2: // Kusama
-		ss58Format = 2
-	default:
-		ss58Format = 42 // Generic substrate
-	}
-
-	address := kp.SS58Address(ss58Format)
+	address := kp.SS58Address(ss58Format(network))
 
 	return &PolkadotSigner{
 		privateKey: secretKey,
@@ -87,3 +117,88 @@ func (s *PolkadotSigner) Sign(message []byte) ([]byte, error) {
 func (s *PolkadotSigner) Address() string {
 	return s.address
 }
+
+// Wallet returns WalletPolkadotJS
+func (s *PolkadotSigner) Wallet() string { return WalletPolkadotJS }
+
+// Scheme returns KeySchemeSr25519
+func (s *PolkadotSigner) Scheme() KeyScheme { return KeySchemeSr25519 }
+
+// Ed25519Signer implements the Signer interface for ed25519 accounts
+type Ed25519Signer struct {
+	keypairSigner
+}
+
+// NewEd25519SignerFromSeed creates a new ed25519 signer from a seed phrase
+func NewEd25519SignerFromSeed(seedPhrase string, network uint16) (*Ed25519Signer, error) {
+	s, err := newKeypairSigner(ed25519.Scheme{}, seedPhrase, network)
+	if err != nil {
+		return nil, err
+	}
+	return &Ed25519Signer{s}, nil
+}
+
+// Scheme returns KeySchemeEd25519
+func (s *Ed25519Signer) Scheme() KeyScheme { return KeySchemeEd25519 }
+
+// EcdsaSigner implements the Signer interface for ecdsa (secp256k1)
+// Substrate accounts, whose address is the blake2-256 hash of the
+// compressed public key. Signatures are 65 bytes over the blake2-256 hash
+// of the message.
+type EcdsaSigner struct {
+	keypairSigner
+}
+
+// NewEcdsaSignerFromSeed creates a new ecdsa signer from a seed phrase
+func NewEcdsaSignerFromSeed(seedPhrase string, network uint16) (*EcdsaSigner, error) {
+	s, err := newKeypairSigner(ecdsa.Scheme{}, seedPhrase, network)
+	if err != nil {
+		return nil, err
+	}
+	return &EcdsaSigner{s}, nil
+}
+
+// Scheme returns KeySchemeEcdsa
+func (s *EcdsaSigner) Scheme() KeyScheme { return KeySchemeEcdsa }
+
+// keypairSigner signs with a go-subkey key pair
+type keypairSigner struct {
+	kp      subkey.KeyPair
+	address string
+}
+
+func newKeypairSigner(scheme subkey.Scheme, seedPhrase string, network uint16) (keypairSigner, error) {
+	kp, err := subkey.DeriveKeyPair(scheme, strings.TrimSpace(seedPhrase))
+	if err != nil {
+		return keypairSigner{}, fmt.Errorf("derive keypair: %w", err)
+	}
+	return keypairSigner{kp: kp, address: kp.SS58Address(ss58Format(network))}, nil
+}
+
+// Sign signs a message with the key pair
+func (s keypairSigner) Sign(message []byte) ([]byte, error) {
+	sig, err := s.kp.Sign(message)
+	if err != nil {
+		return nil, fmt.Errorf("sign message: %w", err)
+	}
+	return sig, nil
+}
+
+// Address returns the SS58 encoded address
+func (s keypairSigner) Address() string {
+	return s.address
+}
+
+// Wallet returns WalletPolkadotJS
+func (s keypairSigner) Wallet() string { return WalletPolkadotJS }
+
+func ss58Format(network uint16) uint16 {
+	switch network {
+	case 0: // Polkadot
+		return 0
+	case 2: // Kusama
+		return 2
+	default:
+		return 42 // Generic substrate
+	}
+}
@@ -0,0 +1,101 @@
+package polkassembly
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vedhavyas/go-subkey/v2"
+	"github.com/vedhavyas/go-subkey/v2/ecdsa"
+	"github.com/vedhavyas/go-subkey/v2/ed25519"
+	"github.com/vedhavyas/go-subkey/v2/sr25519"
+)
+
+func TestSignerSchemes(t *testing.T) {
+	cases := []struct {
+		scheme  KeyScheme
+		subkey  subkey.Scheme
+		address string
+		sigLen  int
+	}{
+		{KeySchemeSr25519, sr25519.Scheme{}, "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", 64},
+		{KeySchemeEd25519, ed25519.Scheme{}, "5FA9nQDVg267DEd8m1ZypXLBnvN7SFxYwV7ndqSYGiN9TTpu", 64},
+		{KeySchemeEcdsa, ecdsa.Scheme{}, "", 65},
+	}
+	for _, tc := range cases {
+		signer, err := NewSignerFromSeed("//Alice", 42, tc.scheme)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.scheme, err)
+		}
+		if tc.address != "" && signer.Address() != tc.address {
+			t.Errorf("%s: got address %s", tc.scheme, signer.Address())
+		}
+		if ws, ok := signer.(WalletSigner); !ok || ws.Wallet() != WalletPolkadotJS {
+			t.Errorf("%s: missing wallet", tc.scheme)
+		}
+
+		msg := []byte("Sign this message to authenticate with Polkassembly")
+		sig, err := signer.Sign(msg)
+		if err != nil || len(sig) != tc.sigLen {
+			t.Fatalf("%s: got %d byte signature, %v", tc.scheme, len(sig), err)
+		}
+		kp, _ := subkey.DeriveKeyPair(tc.subkey, "//Alice")
+		if !kp.Verify(msg, sig) {
+			t.Errorf("%s: signature does not verify", tc.scheme)
+		}
+		if kp.SS58Address(42) != signer.Address() {
+			t.Errorf("%s: address does not match key", tc.scheme)
+		}
+	}
+
+	if _, err := NewSignerFromSeed("//Alice", 42, "bls"); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation for unknown scheme, got %v", err)
+	}
+}
+
+func TestAuthenticateWithSignerSetsWallet(t *testing.T) {
+	var got Web3AuthRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&got)
+		w.Write([]byte(`{"token":"a.b.c"}`))
+	}))
+	defer srv.Close()
+
+	signer, _ := NewEd25519SignerFromSeed("//Alice", 0)
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+	if err := c.AuthenticateWithSigner("polkadot", signer); err != nil {
+		t.Fatal(err)
+	}
+	if got.Wallet != WalletPolkadotJS || got.Address != signer.Address() || len(got.Signature) != 2+128 {
+		t.Errorf("unexpected auth request %+v", got)
+	}
+}
+
+func TestAuthenticateWithSeedScheme(t *testing.T) {
+	var got Web3AuthRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&got)
+		w.Write([]byte(`{"token":"a.b.c"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "kusama"})
+	for _, scheme := range []KeyScheme{KeySchemeSr25519, KeySchemeEd25519, KeySchemeEcdsa} {
+		signer, err := NewSignerFromSeed("//Alice", 2, scheme)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := c.AuthenticateWithSeedScheme("kusama", "//Alice", scheme); err != nil {
+			t.Fatalf("%s: %v", scheme, err)
+		}
+		if got.Address != signer.Address() || got.Wallet != WalletPolkadotJS {
+			t.Errorf("%s: unexpected auth request %+v", scheme, got)
+		}
+	}
+
+	if err := c.AuthenticateWithSeedScheme("kusama", "//Alice", "bls"); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation, got %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+package polkassembly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// activeStatuses are the referendum statuses counted as active proposals
+var activeStatuses = map[string]bool{
+	"Submitted":             true,
+	"DecisionDepositPlaced": true,
+	"Deciding":              true,
+	"ConfirmStarted":        true,
+	"ConfirmAborted":        true,
+	"Active":                true,
+	"Started":               true,
+	"Proposed":              true,
+	"Opened":                true,
+}
+
+// GetNetworkStats retrieves network-wide governance statistics. When the API
+// has no stats endpoint the numbers are derived with DeriveNetworkStats.
+func (c *Client) GetNetworkStats() (*NetworkStats, error) {
+	return c.GetNetworkStatsCtx(context.Background())
+}
+
+// GetNetworkStatsCtx is like GetNetworkStats but carries ctx for cancellation and deadlines
+func (c *Client) GetNetworkStatsCtx(ctx context.Context) (*NetworkStats, error) {
+	r, err := c.newRequest(ctx).
+		Get("/stats")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp NetworkStats
+	if err := c.parseResponse(r, &resp); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return c.DeriveNetworkStatsCtx(ctx)
+		}
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeriveNetworkStats computes network statistics from the listing endpoints
+// and GetDelegationStats. It walks every referendum and the last 30 days of
+// the activity feed, so it issues many requests.
+func (c *Client) DeriveNetworkStats() (*NetworkStats, error) {
+	return c.DeriveNetworkStatsCtx(context.Background())
+}
+
+// DeriveNetworkStatsCtx is like DeriveNetworkStats but carries ctx for cancellation and deadlines
+func (c *Client) DeriveNetworkStatsCtx(ctx context.Context) (*NetworkStats, error) {
+	stats := &NetworkStats{}
+
+	posts := c.AllPosts(ctx, PostListingParams{ProposalType: ProposalTypeReferendumV2}, PageOptions{PageSize: 100, Prefetch: 2})
+	for post, err := range posts {
+		if err != nil {
+			return nil, fmt.Errorf("list referenda: %w", err)
+		}
+		stats.TotalProposals++
+		if activeStatuses[post.Status] {
+			stats.ActiveProposals++
+		}
+		if post.OnChainInfo != nil {
+			stats.TotalVotes += post.OnChainInfo.VoteMetrics.Aye.Count + post.OnChainInfo.VoteMetrics.Nay.Count
+		}
+	}
+
+	// Count is the number of users across all pages, the same total
+	// AllUsers stops at, so one single-item page is enough
+	users, err := c.GetUsersCtx(ctx, UserListingParams{Page: 1, Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("list users: %w", err)
+	}
+	stats.TotalUsers = users.Count
+
+	delegation, err := c.GetDelegationStatsCtx(ctx)
+	switch {
+	case err == nil:
+		stats.TotalDelegations = delegation.TotalDelegations
+		stats.TotalDelegatedBalance = delegation.TotalBalance
+	case !errors.Is(err, ErrNotFound):
+		return nil, fmt.Errorf("delegation stats: %w", err)
+	}
+
+	now := time.Now()
+	weekAgo, monthAgo := now.AddDate(0, 0, -7), now.AddDate(0, -1, 0)
+	weekly, monthly := make(map[string]bool), make(map[string]bool)
+
+	for item, err := range c.AllActivityFeed(ctx, PageOptions{PageSize: 100}) {
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				break
+			}
+			return nil, fmt.Errorf("activity feed: %w", err)
+		}
+		// The feed is newest first, so stop at the first item older than a month
+		if item.CreatedAt.Before(monthAgo) {
+			break
+		}
+		if item.Username == "" {
+			continue
+		}
+		monthly[item.Username] = true
+		if item.CreatedAt.After(weekAgo) {
+			weekly[item.Username] = true
+		}
+	}
+	stats.WeeklyActiveUsers = len(weekly)
+	stats.MonthlyActiveUsers = len(monthly)
+
+	return stats, nil
+}
@@ -0,0 +1,65 @@
+package polkassembly
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetNetworkStatsDerivesWithoutEndpoint(t *testing.T) {
+	now := time.Now()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/stats":
+			w.WriteHeader(http.StatusNotFound)
+		case "/ReferendumV2":
+			var posts []Post
+			for i, status := range []string{"Deciding", "Executed", "ConfirmStarted"} {
+				p := Post{Index: i, Status: status, OnChainInfo: &OnChainInfo{}}
+				p.OnChainInfo.VoteMetrics.Aye.Count = 2
+				p.OnChainInfo.VoteMetrics.Nay.Count = 1
+				posts = append(posts, p)
+			}
+			json.NewEncoder(w).Encode(PostListingResponse{Items: posts, TotalCount: 3})
+		case "/users":
+			if r.URL.Query().Get("limit") != "1" {
+				t.Errorf("users listed with limit %s", r.URL.Query().Get("limit"))
+			}
+			json.NewEncoder(w).Encode(UserListingResponse{Users: []User{{ID: 1}}, Count: 1234})
+		case "/delegation/stats":
+			w.Write([]byte(`{"totalDelegations":7,"totalBalance":"1000"}`))
+		case "/activity-feed":
+			items := []ActivityFeedItem{
+				{Username: "alice", CreatedAt: now.Add(-time.Hour)},
+				{Username: "bob", CreatedAt: now.AddDate(0, 0, -10)},
+				{Username: "alice", CreatedAt: now.AddDate(0, 0, -12)},
+				{Username: "carol", CreatedAt: now.AddDate(0, -2, 0)},
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+	stats, err := c.GetNetworkStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.TotalProposals != 3 || stats.ActiveProposals != 2 || stats.TotalVotes != 9 {
+		t.Errorf("proposals: %+v", stats)
+	}
+	if stats.TotalUsers != 1234 {
+		t.Errorf("total users: got %d, want the listing count 1234", stats.TotalUsers)
+	}
+	if stats.TotalDelegations != 7 || stats.TotalDelegatedBalance.String() != "1000" {
+		t.Errorf("delegations: %+v", stats)
+	}
+	if stats.WeeklyActiveUsers != 1 || stats.MonthlyActiveUsers != 2 {
+		t.Errorf("active users: %d weekly, %d monthly", stats.WeeklyActiveUsers, stats.MonthlyActiveUsers)
+	}
+}
@@ -0,0 +1,266 @@
+package polkassembly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Timeline entry types for the lifecycle of an OpenGov referendum
+const (
+	TimelineSubmitted             = "Submitted"
+	TimelineDecisionDepositPlaced = "DecisionDepositPlaced"
+	TimelinePreparePeriodEnds     = "PreparePeriodEnds"
+	TimelineDeciding              = "Deciding"
+	TimelineDecisionPeriodEnds    = "DecisionPeriodEnds"
+	TimelineConfirmStarted        = "ConfirmStarted"
+	TimelineConfirmAborted        = "ConfirmAborted"
+	TimelineConfirmed             = "Confirmed"
+	TimelineRejected              = "Rejected"
+	TimelineTimedOut              = "TimedOut"
+	TimelineCancelled             = "Cancelled"
+	TimelineKilled                = "Killed"
+	TimelineExecuted              = "Executed"
+
+	// Deadlines derived from the track's periods rather than reported
+	TimelineConfirmPeriodEnds = "ConfirmPeriodEnds"
+	TimelineEnactment         = "Enactment"
+)
+
+// timelineTitles are the titles of derived entries by type
+var timelineTitles = map[string]string{
+	TimelineSubmitted:             "Proposal submitted",
+	TimelineDecisionDepositPlaced: "Decision deposit placed",
+	TimelinePreparePeriodEnds:     "Prepare period ends",
+	TimelineDeciding:              "Decision period started",
+	TimelineDecisionPeriodEnds:    "Decision period ends",
+	TimelineConfirmStarted:        "Confirm period started",
+	TimelineConfirmAborted:        "Confirm period aborted",
+	TimelineConfirmPeriodEnds:     "Confirm period ends",
+	TimelineConfirmed:             "Referendum confirmed",
+	TimelineEnactment:             "Earliest enactment",
+	TimelineRejected:              "Referendum rejected",
+	TimelineTimedOut:              "Referendum timed out",
+	TimelineCancelled:             "Referendum cancelled",
+	TimelineKilled:                "Referendum killed",
+	TimelineExecuted:              "Proposal executed",
+}
+
+// importantTimelineTypes are the milestones worth highlighting in a UI
+var importantTimelineTypes = map[string]bool{
+	TimelineSubmitted: true,
+	TimelineDeciding:  true,
+	TimelineConfirmed: true,
+	TimelineRejected:  true,
+	TimelineTimedOut:  true,
+	TimelineCancelled: true,
+	TimelineKilled:    true,
+	TimelineExecuted:  true,
+}
+
+// GetProposalTimeline returns the lifecycle of a proposal ordered by time.
+// Status events reported by the API are merged with the submission and
+// period deadlines from the post's on-chain info.
+func (c *Client) GetProposalTimeline(proposalType ProposalType, postID int) ([]TimelineEntry, error) {
+	return c.GetProposalTimelineCtx(context.Background(), proposalType, postID)
+}
+
+// GetProposalTimelineCtx is like GetProposalTimeline but carries ctx for cancellation and deadlines
+func (c *Client) GetProposalTimelineCtx(ctx context.Context, proposalType ProposalType, postID int) ([]TimelineEntry, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := c.GetPostByTypeCtx(ctx, postID, proposalType)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := c.newRequest(ctx).
+		Get(fmt.Sprintf("/%s/%d/timeline", proposalType, postID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Items []TimelineEntry `json:"items"`
+	}
+	if err := c.parseResponse(r, &resp); err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	return mergeTimeline(resp.Items, timelineFromPost(post, c.Network())), nil
+}
+
+// GetNetworkTimeline returns governance events across a network, filtered
+// by params.Types and the date range and ordered by time
+func (c *Client) GetNetworkTimeline(params TimelineParams) ([]TimelineEntry, error) {
+	return c.GetNetworkTimelineCtx(context.Background(), params)
+}
+
+// GetNetworkTimelineCtx is like GetNetworkTimeline but carries ctx for cancellation and deadlines
+func (c *Client) GetNetworkTimelineCtx(ctx context.Context, params TimelineParams) ([]TimelineEntry, error) {
+	queryParams := make(map[string]string)
+	if len(params.Types) > 0 {
+		queryParams["types"] = strings.Join(params.Types, ",")
+	}
+	if !params.DateFrom.IsZero() {
+		queryParams["dateFrom"] = params.DateFrom.UTC().Format(time.RFC3339)
+	}
+	if !params.DateTo.IsZero() {
+		queryParams["dateTo"] = params.DateTo.UTC().Format(time.RFC3339)
+	}
+	if params.Page > 0 {
+		queryParams["page"] = fmt.Sprintf("%d", params.Page)
+	}
+	if params.Limit > 0 {
+		queryParams["limit"] = fmt.Sprintf("%d", params.Limit)
+	}
+
+	client := c
+	if params.Network != "" && params.Network != c.Network() {
+		client = c.WithNetwork(params.Network)
+	}
+
+	r, err := client.newRequest(ctx).
+		SetQueryParams(queryParams).
+		Get("/timeline")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Items []TimelineEntry `json:"items"`
+	}
+	if err := c.parseResponse(r, &resp); err != nil {
+		return nil, err
+	}
+
+	// Filter again locally in case the server ignores some of the filters
+	entries := resp.Items[:0]
+	for _, e := range resp.Items {
+		if params.matches(e) {
+			entries = append(entries, e)
+		}
+	}
+	sortTimeline(entries)
+
+	return entries, nil
+}
+
+func (p TimelineParams) matches(e TimelineEntry) bool {
+	if len(p.Types) > 0 {
+		found := false
+		for _, t := range p.Types {
+			if strings.EqualFold(t, e.Type) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if !p.DateFrom.IsZero() && e.CreatedAt.Before(p.DateFrom) {
+		return false
+	}
+	if !p.DateTo.IsZero() && e.CreatedAt.After(p.DateTo) {
+		return false
+	}
+	return true
+}
+
+// timelineFromPost derives lifecycle entries from a post's on-chain info:
+// one per status change, plus the prepare and decision deadlines and, when
+// the track is known, the end of a running confirm period and the earliest
+// enactment of a confirmed referendum
+func timelineFromPost(post *Post, network string) []TimelineEntry {
+	info := post.OnChainInfo
+	if info == nil {
+		return nil
+	}
+	if post.Network != "" {
+		network = post.Network
+	}
+
+	var entries []TimelineEntry
+	add := func(typ string, at time.Time) {
+		if at.IsZero() {
+			return
+		}
+		title := timelineTitles[typ]
+		if title == "" {
+			title = typ
+		}
+		entries = append(entries, TimelineEntry{
+			Type:        typ,
+			Title:       title,
+			Author:      info.Proposer,
+			CreatedAt:   at,
+			Network:     network,
+			IsImportant: importantTimelineTypes[typ],
+		})
+	}
+
+	submitted := false
+	var last StatusChange
+	for _, change := range info.StatusHistory {
+		add(change.Status, change.Timestamp)
+		if change.Status == TimelineSubmitted {
+			submitted = true
+		}
+		last = change
+	}
+	if !submitted {
+		add(TimelineSubmitted, info.CreatedAt)
+	}
+	add(TimelinePreparePeriodEnds, info.PreparePeriodEndsAt)
+	add(TimelineDecisionPeriodEnds, info.DecisionPeriodEndsAt)
+
+	// Upcoming deadlines only apply while the referendum sits in the
+	// status they follow
+	track, err := postTrack(post, network)
+	if err != nil {
+		return entries
+	}
+	switch last.Status {
+	case TimelineConfirmStarted:
+		add(TimelineConfirmPeriodEnds, last.Timestamp.Add(BlocksToDuration(track.ConfirmPeriod)))
+	case TimelineConfirmed:
+		add(TimelineEnactment, last.Timestamp.Add(BlocksToDuration(track.MinEnactmentPeriod)))
+	}
+
+	return entries
+}
+
+// mergeTimeline combines API events with derived entries. An API event of
+// the same type replaces the derived one since it carries the actual time.
+func mergeTimeline(events, derived []TimelineEntry) []TimelineEntry {
+	seen := make(map[string]bool, len(events))
+	merged := make([]TimelineEntry, 0, len(events)+len(derived))
+	for _, e := range events {
+		if !e.IsImportant {
+			e.IsImportant = importantTimelineTypes[e.Type]
+		}
+		seen[e.Type] = true
+		merged = append(merged, e)
+	}
+	for _, e := range derived {
+		if !seen[e.Type] {
+			merged = append(merged, e)
+		}
+	}
+
+	sortTimeline(merged)
+	return merged
+}
+
+func sortTimeline(entries []TimelineEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+}
@@ -0,0 +1,112 @@
+package polkassembly
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimelineFromPost(t *testing.T) {
+	base := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return base.Add(time.Duration(h) * time.Hour) }
+
+	post := &Post{
+		Network:     "polkadot",
+		TrackNumber: 33,
+		OnChainInfo: &OnChainInfo{
+			CreatedAt:            at(0),
+			Proposer:             "alice",
+			PreparePeriodEndsAt:  at(96),
+			DecisionPeriodEndsAt: at(96 + 28*24),
+			StatusHistory: []StatusChange{
+				{Status: "Submitted", Timestamp: at(0)},
+				{Status: "DecisionDepositPlaced", Timestamp: at(2)},
+				{Status: "Deciding", Timestamp: at(96)},
+				{Status: "ConfirmStarted", Timestamp: at(200)},
+				{Status: "ConfirmAborted", Timestamp: at(210)},
+				{Status: "ConfirmStarted", Timestamp: at(300)},
+			},
+		},
+	}
+	track, _ := TrackByID("polkadot", 33)
+
+	entries := mergeTimeline(nil, timelineFromPost(post, "kusama"))
+	want := []struct {
+		typ string
+		at  time.Time
+	}{
+		{TimelineSubmitted, at(0)},
+		{TimelineDecisionDepositPlaced, at(2)},
+		{TimelineDeciding, at(96)},
+		{TimelinePreparePeriodEnds, at(96)},
+		{TimelineConfirmStarted, at(200)},
+		{TimelineConfirmAborted, at(210)},
+		{TimelineConfirmStarted, at(300)},
+		{TimelineConfirmPeriodEnds, at(300).Add(BlocksToDuration(track.ConfirmPeriod))},
+		{TimelineDecisionPeriodEnds, at(96 + 28*24)},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries: %+v", len(entries), entries)
+	}
+	for i, w := range want {
+		e := entries[i]
+		if e.Type != w.typ || !e.CreatedAt.Equal(w.at) {
+			t.Errorf("entry %d: got %s at %v, want %s at %v", i, e.Type, e.CreatedAt, w.typ, w.at)
+		}
+		if e.Network != "polkadot" || e.Author != "alice" || e.Title == "" {
+			t.Errorf("entry %d: %+v", i, e)
+		}
+	}
+	if !entries[0].IsImportant || entries[1].IsImportant {
+		t.Error("importance not set from the entry type")
+	}
+
+	// Once confirmed, the confirm deadline gives way to the enactment
+	post.OnChainInfo.StatusHistory = append(post.OnChainInfo.StatusHistory, StatusChange{Status: "Confirmed", Timestamp: at(324)})
+	entries = timelineFromPost(post, "")
+	last := entries[len(entries)-1]
+	if last.Type != TimelineEnactment || !last.CreatedAt.Equal(at(324).Add(BlocksToDuration(track.MinEnactmentPeriod))) {
+		t.Errorf("expected enactment entry, got %+v", last)
+	}
+	for _, e := range entries {
+		if e.Type == TimelineConfirmPeriodEnds {
+			t.Error("confirm period end listed after confirmation")
+		}
+	}
+
+	// Without history the submission comes from the creation time
+	entries = timelineFromPost(&Post{OnChainInfo: &OnChainInfo{CreatedAt: at(0)}}, "polkadot")
+	if len(entries) != 1 || entries[0].Type != TimelineSubmitted {
+		t.Errorf("got %+v", entries)
+	}
+	if timelineFromPost(&Post{}, "polkadot") != nil {
+		t.Error("expected no entries without on-chain info")
+	}
+}
+
+func TestMergeTimeline(t *testing.T) {
+	base := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	events := []TimelineEntry{
+		{Type: TimelineDeciding, CreatedAt: base.Add(3 * time.Hour), Title: "from API"},
+		{Type: "Comment", CreatedAt: base.Add(time.Hour)},
+	}
+	derived := []TimelineEntry{
+		{Type: TimelineSubmitted, CreatedAt: base},
+		{Type: TimelineDeciding, CreatedAt: base.Add(2 * time.Hour), Title: "derived"},
+		{Type: TimelineDecisionPeriodEnds, CreatedAt: base.Add(4 * time.Hour)},
+	}
+
+	merged := mergeTimeline(events, derived)
+	types := []string{TimelineSubmitted, "Comment", TimelineDeciding, TimelineDecisionPeriodEnds}
+	if len(merged) != len(types) {
+		t.Fatalf("got %+v", merged)
+	}
+	for i, typ := range types {
+		if merged[i].Type != typ {
+			t.Errorf("entry %d: got %s, want %s", i, merged[i].Type, typ)
+		}
+	}
+	// The API event replaces the derived one and is flagged as important
+	if merged[2].Title != "from API" || !merged[2].IsImportant || merged[1].IsImportant {
+		t.Errorf("unexpected merge %+v", merged)
+	}
+}
@@ -0,0 +1,184 @@
+package polkassembly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// tipPost is a Tip post as returned by the API. The on-chain info of a tip
+// carries the beneficiary, finder and individual tipper values.
+type tipPost struct {
+	Post
+	OnChainInfo *struct {
+		OnChainInfo
+		Who    string    `json:"who"`
+		Finder string    `json:"finder"`
+		Reason string    `json:"reason"`
+		Tips   []TipInfo `json:"tips"`
+	} `json:"onChainInfo,omitempty"`
+}
+
+// GetTips retrieves tips, optionally filtered by status
+func (c *Client) GetTips(params TipListingParams) (*TipListingResponse, error) {
+	return c.GetTipsCtx(context.Background(), params)
+}
+
+// GetTipsCtx is like GetTips but carries ctx for cancellation and deadlines
+func (c *Client) GetTipsCtx(ctx context.Context, params TipListingParams) (*TipListingResponse, error) {
+	queryParams := make(map[string]string)
+	if params.Page > 0 {
+		queryParams["page"] = fmt.Sprintf("%d", params.Page)
+	}
+	if params.Limit > 0 {
+		queryParams["limit"] = fmt.Sprintf("%d", params.Limit)
+	}
+	if params.Status != "" {
+		queryParams["status"] = params.Status
+	}
+
+	r, err := c.newRequest(ctx).
+		SetQueryParams(queryParams).
+		Get("/Tip")
+	if err != nil {
+		return nil, err
+	}
+
+	var listing struct {
+		Items      []tipPost `json:"items"`
+		TotalCount int       `json:"totalCount"`
+	}
+	if err := c.parseResponse(r, &listing); err != nil {
+		return nil, err
+	}
+
+	resp := &TipListingResponse{TotalCount: listing.TotalCount}
+	for _, item := range listing.Items {
+		resp.Tips = append(resp.Tips, item.tip())
+	}
+
+	return resp, nil
+}
+
+// GetTip retrieves a tip by hash including each tipper's value
+func (c *Client) GetTip(hash string) (*Tip, error) {
+	return c.GetTipCtx(context.Background(), hash)
+}
+
+// GetTipCtx is like GetTip but carries ctx for cancellation and deadlines
+func (c *Client) GetTipCtx(ctx context.Context, hash string) (*Tip, error) {
+	r, err := c.newRequest(ctx).
+		Get(fmt.Sprintf("/Tip/%s", hash))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(r); err != nil {
+		return nil, err
+	}
+
+	// Single post responses may or may not be wrapped in "data". A wrapped
+	// body also decodes as an empty tipPost, so look for the wrapper first.
+	var wrapped struct {
+		Data *tipPost `json:"data"`
+	}
+	if err := json.Unmarshal(r.Body(), &wrapped); err != nil {
+		return nil, fmt.Errorf("unmarshal tip: %w", err)
+	}
+	var post tipPost
+	if wrapped.Data != nil {
+		post = *wrapped.Data
+	} else if err := json.Unmarshal(r.Body(), &post); err != nil {
+		return nil, fmt.Errorf("unmarshal tip: %w", err)
+	}
+
+	tip := post.tip()
+	if tip.Hash == "" {
+		tip.Hash = hash
+	}
+	return &tip, nil
+}
+
+// CreateTip creates the discussion post for a tip
+func (c *Client) CreateTip(req CreateTipRequest) (*Tip, error) {
+	return c.CreateTipCtx(context.Background(), req)
+}
+
+// CreateTipCtx is like CreateTip but carries ctx for cancellation and deadlines
+func (c *Client) CreateTipCtx(ctx context.Context, req CreateTipRequest) (*Tip, error) {
+	if req.Hash == "" {
+		return nil, fmt.Errorf("%w: tip hash is required", ErrValidation)
+	}
+
+	r, err := c.newRequest(ctx).
+		SetBody(req).
+		Post("/Tip")
+	if err != nil {
+		return nil, err
+	}
+
+	var post tipPost
+	if err := c.parseResponse(r, &post); err != nil {
+		return nil, err
+	}
+
+	tip := post.tip()
+	if tip.Hash == "" {
+		tip.Hash = req.Hash
+	}
+	if tip.Who == "" {
+		tip.Who = req.Who
+	}
+	if tip.Reason == "" {
+		tip.Reason = req.Reason
+	}
+	return &tip, nil
+}
+
+func (p tipPost) tip() Tip {
+	tip := Tip{
+		Hash:      p.TipHash,
+		Status:    p.Status,
+		CreatedAt: p.CreatedAt,
+		Reason:    p.Title,
+	}
+	if tip.Hash == "" {
+		tip.Hash = p.Hash
+	}
+
+	if info := p.OnChainInfo; info != nil {
+		if tip.Hash == "" {
+			tip.Hash = info.Hash
+		}
+		if info.Status != "" {
+			tip.Status = info.Status
+		}
+		if !info.CreatedAt.IsZero() {
+			tip.CreatedAt = info.CreatedAt
+		}
+		if info.Reason != "" {
+			tip.Reason = info.Reason
+		}
+		tip.Who = info.Who
+		tip.Finder = info.Finder
+		tip.Tips = info.Tips
+	}
+
+	return tip
+}
+
+// MedianTip returns the tip amount that would be paid out, computed the same
+// way as the runtime: the values are sorted and the upper median is taken.
+// It returns zero when nobody has tipped yet.
+func (t *Tip) MedianTip() Balance {
+	values := make([]Balance, 0, len(t.Tips))
+	for _, info := range t.Tips {
+		values = append(values, info.Value)
+	}
+	if len(values) == 0 {
+		return Balance{}
+	}
+
+	sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+	return values[len(values)/2]
+}
@@ -0,0 +1,66 @@
+package polkassembly
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMedianTip(t *testing.T) {
+	tip := func(values ...int64) *Tip {
+		t := &Tip{}
+		for _, v := range values {
+			t.Tips = append(t.Tips, TipInfo{Value: NewBalanceInt64(v)})
+		}
+		return t
+	}
+
+	cases := []struct {
+		tip  *Tip
+		want string
+	}{
+		{tip(), "0"},
+		{tip(50), "50"},
+		{tip(30, 10, 20), "20"},
+		// Even counts take the upper median, like pallet-tips
+		{tip(40, 10, 30, 20), "30"},
+		{tip(5, 5), "5"},
+	}
+	for _, tc := range cases {
+		if got := tc.tip.MedianTip().String(); got != tc.want {
+			t.Errorf("%d tips: got %s, want %s", len(tc.tip.Tips), got, tc.want)
+		}
+	}
+}
+
+func TestGetTipUnwrapsData(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Tip/0xabc" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"data":{"title":"Thanks","status":"Opened","onChainInfo":{
+			"who":"alice","finder":"bob","reason":"Great work",
+			"tips":[{"tipper":"c1","value":"10"},{"tipper":"c2","value":"0x1e"}]}}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "kusama"})
+	tip, err := c.GetTip("0xabc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tip.Hash != "0xabc" || tip.Who != "alice" || tip.Finder != "bob" || tip.Reason != "Great work" || tip.Status != "Opened" {
+		t.Errorf("unexpected tip %+v", tip)
+	}
+	if got := tip.MedianTip().String(); got != "30" {
+		t.Errorf("median: got %s", got)
+	}
+}
+
+func TestCreateTipRequiresHash(t *testing.T) {
+	c := NewClient(Config{BaseURL: "http://127.0.0.1:0", Network: "polkadot"})
+	if _, err := c.CreateTip(CreateTipRequest{Reason: "docs"}); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation, got %v", err)
+	}
+}
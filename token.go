@@ -0,0 +1,98 @@
+package polkassembly
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TokenClaims are the claims of a Polkassembly access token. They are read
+// from the JWT payload without verifying the signature, which only the API
+// can do.
+type TokenClaims struct {
+	Subject  string
+	UserID   int
+	IssuedAt time.Time
+	// Expiry is zero when the token does not expire
+	Expiry time.Time
+	// Claims holds every claim of the payload, with numbers as json.Number
+	Claims map[string]interface{}
+}
+
+// ParseToken decodes the claims of a JWT access token
+func ParseToken(token string) (*TokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: token is not a JWT", ErrValidation)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode token payload: %v", ErrValidation, err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	var claims map[string]interface{}
+	if err := dec.Decode(&claims); err != nil {
+		return nil, fmt.Errorf("%w: parse token payload: %v", ErrValidation, err)
+	}
+
+	t := &TokenClaims{Claims: claims}
+	t.Subject, _ = claims["sub"].(string)
+	t.IssuedAt = claimTime(claims["iat"])
+	t.Expiry = claimTime(claims["exp"])
+
+	// The user ID is the id claim, or the subject when it is numeric
+	if id, ok := claimInt(claims["id"]); ok {
+		t.UserID = id
+	} else if id, err := strconv.Atoi(t.Subject); err == nil {
+		t.UserID = id
+	}
+	return t, nil
+}
+
+// Expired reports whether the token has expired at now. Tokens without an
+// expiry never expire.
+func (t *TokenClaims) Expired(now time.Time) bool {
+	return !t.Expiry.IsZero() && !now.Before(t.Expiry)
+}
+
+// TokenClaims returns the claims of the client's current token
+func (c *Client) TokenClaims() (*TokenClaims, error) {
+	c.mu.RLock()
+	token := c.token
+	c.mu.RUnlock()
+	if token == "" {
+		return nil, fmt.Errorf("%w: no auth token", ErrValidation)
+	}
+	return ParseToken(token)
+}
+
+func claimInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		i, err := n.Int64()
+		return int(i), err == nil
+	case string:
+		i, err := strconv.Atoi(n)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+// claimTime converts a NumericDate claim (seconds since the epoch)
+func claimTime(v interface{}) time.Time {
+	n, ok := v.(json.Number)
+	if !ok {
+		return time.Time{}
+	}
+	secs, err := n.Float64()
+	if err != nil || secs <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(secs*float64(time.Second)))
+}
@@ -0,0 +1,44 @@
+package polkassembly
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+// testJWT builds an unsigned JWT with the given claims
+func testJWT(claims map[string]interface{}) string {
+	payload, _ := json.Marshal(claims)
+	return "eyJhbGciOiJIUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
+func TestParseToken(t *testing.T) {
+	exp := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	claims, err := ParseToken(testJWT(map[string]interface{}{
+		"sub": "alice", "id": 4711, "iat": exp.Add(-time.Hour).Unix(), "exp": exp.Unix(),
+		"username": "alice",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Subject != "alice" || claims.UserID != 4711 || !claims.Expiry.Equal(exp) ||
+		!claims.IssuedAt.Equal(exp.Add(-time.Hour)) || claims.Claims["username"] != "alice" {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+	if claims.Expired(exp.Add(-time.Second)) || !claims.Expired(exp) {
+		t.Error("expiry check is off")
+	}
+
+	claims, _ = ParseToken(testJWT(map[string]interface{}{"sub": "42"}))
+	if claims.UserID != 42 || !claims.Expiry.IsZero() || claims.Expired(time.Now()) {
+		t.Errorf("numeric subject without expiry: %+v", claims)
+	}
+
+	for _, token := range []string{"opaque-session-token", "a.!!!.c", "a." + base64.RawURLEncoding.EncodeToString([]byte("[]")) + ".c"} {
+		if _, err := ParseToken(token); !errors.Is(err, ErrValidation) {
+			t.Errorf("%q: expected ErrValidation, got %v", token, err)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package polkassembly
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// MemoryTokenStorage keeps the token in memory. The zero value is ready to use.
+type MemoryTokenStorage struct {
+	mu    sync.Mutex
+	token string
+}
+
+func (s *MemoryTokenStorage) SaveToken(token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+	return nil
+}
+
+func (s *MemoryTokenStorage) GetToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token, nil
+}
+
+func (s *MemoryTokenStorage) DeleteToken() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+	return nil
+}
+
+// FileTokenStorage keeps the token in a file readable only by its owner
+type FileTokenStorage struct {
+	Path string
+}
+
+// NewFileTokenStorage returns a store for the token of one account on one
+// network, under the user's config directory ($XDG_CONFIG_HOME on Linux):
+// polkassembly/tokens/<network>/<address>
+func NewFileTokenStorage(network, address string) (*FileTokenStorage, error) {
+	if network == "" || address == "" {
+		return nil, fmt.Errorf("%w: network and address are required", ErrValidation)
+	}
+	if strings.ContainsAny(network+address, `/\`) || network == ".." || address == ".." {
+		return nil, fmt.Errorf("%w: invalid network or address", ErrValidation)
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("config dir: %w", err)
+	}
+	return &FileTokenStorage{Path: filepath.Join(dir, "polkassembly", "tokens", network, address)}, nil
+}
+
+// SaveToken writes the token atomically with mode 0600, creating missing
+// directories with mode 0700
+func (s *FileTokenStorage) SaveToken(token string) error {
+	dir := filepath.Dir(s.Path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create token dir: %w", err)
+	}
+
+	f, err := os.CreateTemp(dir, ".token-*")
+	if err != nil {
+		return fmt.Errorf("create token file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if err := f.Chmod(0o600); err != nil {
+		f.Close()
+		return fmt.Errorf("chmod token file: %w", err)
+	}
+	if _, err := f.WriteString(token); err != nil {
+		f.Close()
+		return fmt.Errorf("write token file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("write token file: %w", err)
+	}
+	if err := os.Rename(f.Name(), s.Path); err != nil {
+		return fmt.Errorf("write token file: %w", err)
+	}
+	return nil
+}
+
+// GetToken returns the stored token, or "" when none is stored
+func (s *FileTokenStorage) GetToken() (string, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("read token file: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (s *FileTokenStorage) DeleteToken() error {
+	if err := os.Remove(s.Path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete token file: %w", err)
+	}
+	return nil
+}
+
+// scrypt parameters for deriving the AES-256 key from the passphrase
+const (
+	scryptN       = 1 << 15
+	scryptR       = 8
+	scryptP       = 1
+	scryptSaltLen = 16
+	encryptedTag  = "scrypt-aes256gcm:"
+)
+
+// ErrWrongPassphrase is returned by EncryptedTokenStorage when the stored
+// token cannot be decrypted with the passphrase
+var ErrWrongPassphrase = errors.New("polkassembly: wrong passphrase or corrupted token")
+
+// EncryptedTokenStorage encrypts the token with a key derived from a
+// passphrase (scrypt) using AES-256-GCM before handing it to another
+// TokenStorage, typically a FileTokenStorage
+type EncryptedTokenStorage struct {
+	inner      TokenStorage
+	passphrase []byte
+}
+
+// NewEncryptedTokenStorage wraps inner so that tokens are stored encrypted
+func NewEncryptedTokenStorage(inner TokenStorage, passphrase string) (*EncryptedTokenStorage, error) {
+	if inner == nil {
+		return nil, fmt.Errorf("%w: inner storage is required", ErrValidation)
+	}
+	if passphrase == "" {
+		return nil, fmt.Errorf("%w: passphrase is required", ErrValidation)
+	}
+	return &EncryptedTokenStorage{inner: inner, passphrase: []byte(passphrase)}, nil
+}
+
+// SaveToken encrypts the token with a fresh salt and nonce
+func (s *EncryptedTokenStorage) SaveToken(token string) error {
+	salt := make([]byte, scryptSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("generate salt: %w", err)
+	}
+	gcm, err := s.cipher(salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("generate nonce: %w", err)
+	}
+
+	// salt | nonce | ciphertext, with the salt authenticated as well
+	sealed := append(append(salt, nonce...), gcm.Seal(nil, nonce, []byte(token), salt)...)
+	return s.inner.SaveToken(encryptedTag + base64.StdEncoding.EncodeToString(sealed))
+}
+
+// GetToken decrypts the stored token. A wrong passphrase returns
+// ErrWrongPassphrase.
+func (s *EncryptedTokenStorage) GetToken() (string, error) {
+	stored, err := s.inner.GetToken()
+	if err != nil || stored == "" {
+		return "", err
+	}
+
+	encoded, ok := strings.CutPrefix(stored, encryptedTag)
+	if !ok {
+		return "", fmt.Errorf("stored token is not encrypted")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < scryptSaltLen {
+		return "", ErrWrongPassphrase
+	}
+
+	salt := sealed[:scryptSaltLen]
+	gcm, err := s.cipher(salt)
+	if err != nil {
+		return "", err
+	}
+	rest := sealed[scryptSaltLen:]
+	if len(rest) < gcm.NonceSize() {
+		return "", ErrWrongPassphrase
+	}
+	plain, err := gcm.Open(nil, rest[:gcm.NonceSize()], rest[gcm.NonceSize():], salt)
+	if err != nil {
+		return "", ErrWrongPassphrase
+	}
+	return string(plain), nil
+}
+
+func (s *EncryptedTokenStorage) DeleteToken() error {
+	return s.inner.DeleteToken()
+}
+
+func (s *EncryptedTokenStorage) cipher(salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(s.passphrase, salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, fmt.Errorf("derive key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,125 @@
+package polkassembly
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMemoryTokenStorage(t *testing.T) {
+	var s MemoryTokenStorage
+	s.SaveToken("abc")
+	if tok, _ := s.GetToken(); tok != "abc" {
+		t.Errorf("got %q", tok)
+	}
+	s.DeleteToken()
+	if tok, _ := s.GetToken(); tok != "" {
+		t.Errorf("expected empty token after delete, got %q", tok)
+	}
+}
+
+func TestFileTokenStorage(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+
+	s, err := NewFileTokenStorage("polkadot", "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(s.Path, dir) || !strings.HasSuffix(s.Path, filepath.Join("polkassembly", "tokens", "polkadot", "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5")) {
+		t.Errorf("unexpected path %s", s.Path)
+	}
+
+	if tok, err := s.GetToken(); err != nil || tok != "" {
+		t.Errorf("missing file: got %q, %v", tok, err)
+	}
+	if err := s.SaveToken("a.b.c"); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(s.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("expected mode 0600, got %v", info.Mode().Perm())
+	}
+	if tok, _ := s.GetToken(); tok != "a.b.c" {
+		t.Errorf("got %q", tok)
+	}
+	if err := s.DeleteToken(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DeleteToken(); err != nil {
+		t.Errorf("deleting a missing token: %v", err)
+	}
+
+	if _, err := NewFileTokenStorage("polkadot", "../escape"); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation for path separators, got %v", err)
+	}
+}
+
+func TestEncryptedTokenStorage(t *testing.T) {
+	inner := &MemoryTokenStorage{}
+	s, err := NewEncryptedTokenStorage(inner, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SaveToken("secret.jwt.token"); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, _ := inner.GetToken()
+	if strings.Contains(stored, "secret") {
+		t.Fatalf("token stored in plain text: %s", stored)
+	}
+	if tok, err := s.GetToken(); err != nil || tok != "secret.jwt.token" {
+		t.Errorf("got %q, %v", tok, err)
+	}
+
+	wrong, _ := NewEncryptedTokenStorage(inner, "battery staple")
+	if _, err := wrong.GetToken(); !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("expected ErrWrongPassphrase, got %v", err)
+	}
+}
+
+type failingStorage struct{ MemoryTokenStorage }
+
+func (*failingStorage) SaveToken(string) error { return errors.New("disk full") }
+
+func TestSetAuthTokenSurfacesSaveError(t *testing.T) {
+	c := NewClient(Config{BaseURL: "http://127.0.0.1:0", Network: "polkadot", TokenStorage: &failingStorage{}})
+	err := c.SetAuthToken("tok")
+	if err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("expected save error, got %v", err)
+	}
+}
+
+type unreadableStorage struct{ MemoryTokenStorage }
+
+func (*unreadableStorage) GetToken() (string, error) { return "", errors.New("permission denied") }
+
+func TestOpenClientSurfacesStorageErrors(t *testing.T) {
+	cfg := Config{BaseURL: "http://127.0.0.1:0", Network: "polkadot", Token: "tok", TokenStorage: &failingStorage{}}
+	if _, err := OpenClient(cfg); err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("expected save error, got %v", err)
+	}
+	// NewClient treats the initial save as best-effort and keeps the token
+	if c := NewClient(cfg); c.token != "tok" {
+		t.Errorf("token = %q", c.token)
+	}
+
+	cfg = Config{BaseURL: "http://127.0.0.1:0", Network: "polkadot", TokenStorage: &unreadableStorage{}}
+	if _, err := OpenClient(cfg); err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Errorf("expected load error, got %v", err)
+	}
+
+	store := &MemoryTokenStorage{}
+	store.SaveToken("stored")
+	c, err := OpenClient(Config{BaseURL: "http://127.0.0.1:0", Network: "polkadot", TokenStorage: store})
+	if err != nil || c.token != "stored" {
+		t.Errorf("got %v, %v", c, err)
+	}
+}
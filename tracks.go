@@ -0,0 +1,344 @@
+package polkassembly
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// BlockTime is the target block time of the relay chains
+const BlockTime = 6 * time.Second
+
+const (
+	blocksPerMinute = 10
+	blocksPerHour   = 60 * blocksPerMinute
+	blocksPerDay    = 24 * blocksPerHour
+)
+
+// BlocksToDuration converts a number of blocks to wall-clock time
+func BlocksToDuration(blocks uint32) time.Duration {
+	return time.Duration(blocks) * BlockTime
+}
+
+// CurveKind identifies the shape of an approval or support curve
+type CurveKind string
+
+const (
+	CurveLinearDecreasing CurveKind = "LinearDecreasing"
+	CurveReciprocal       CurveKind = "Reciprocal"
+)
+
+// Curve is a referendum threshold as a function of the elapsed fraction of
+// the decision period, using the same parametrisation as pallet-referenda.
+// All values are fractions between 0 and 1.
+type Curve struct {
+	Kind CurveKind
+
+	// LinearDecreasing falls from Ceil to Floor over Length and stays there
+	Length float64
+	Floor  float64
+	Ceil   float64
+
+	// Reciprocal is Factor / (x + XOffset) + YOffset
+	Factor  float64
+	XOffset float64
+	YOffset float64
+}
+
+// linearCurve mirrors Curve::make_linear: the threshold falls from ceil to
+// floor over length/period of the decision period
+func linearCurve(length, period int, floor, ceil float64) Curve {
+	return Curve{
+		Kind:   CurveLinearDecreasing,
+		Length: float64(length) / float64(period),
+		Floor:  floor,
+		Ceil:   ceil,
+	}
+}
+
+// reciprocalCurve mirrors Curve::make_reciprocal: the curve starts at ceil,
+// passes through level at delay/period and reaches floor at the end of the
+// decision period
+func reciprocalCurve(delay, period int, level, floor, ceil float64) Curve {
+	d := float64(delay) / float64(period)
+	r := (ceil - level) / (ceil - floor)
+	xOffset := d * (r - 1) / (d - r)
+	factor := (ceil - floor) * xOffset * (1 + xOffset)
+	return Curve{
+		Kind:    CurveReciprocal,
+		Factor:  factor,
+		XOffset: xOffset,
+		YOffset: ceil - factor/xOffset,
+	}
+}
+
+// Threshold returns the required approval or support once fraction x of the
+// decision period has elapsed
+func (c Curve) Threshold(x float64) float64 {
+	x = clampUnit(x)
+	switch c.Kind {
+	case CurveLinearDecreasing:
+		if c.Length <= 0 {
+			return c.Floor
+		}
+		return c.Ceil - math.Min(x, c.Length)/c.Length*(c.Ceil-c.Floor)
+	case CurveReciprocal:
+		return clampUnit(c.Factor/(x+c.XOffset) + c.YOffset)
+	}
+	return 1
+}
+
+// Delay returns the elapsed fraction of the decision period at which the
+// threshold falls to y. It is 0 when y is already met at the start and 1
+// when y is never met.
+func (c Curve) Delay(y float64) float64 {
+	switch c.Kind {
+	case CurveLinearDecreasing:
+		switch {
+		case y < c.Floor:
+			return 1
+		case y >= c.Ceil:
+			return 0
+		}
+		return (c.Ceil - y) / (c.Ceil - c.Floor) * c.Length
+	case CurveReciprocal:
+		term := y - c.YOffset
+		if term <= 0 {
+			return 1
+		}
+		return clampUnit(c.Factor/term - c.XOffset)
+	}
+	return 1
+}
+
+func clampUnit(x float64) float64 {
+	return math.Max(0, math.Min(1, x))
+}
+
+// Track is an OpenGov referendum track. Periods are in blocks.
+type Track struct {
+	ID                 int
+	Name               string
+	Origin             string
+	MaxDeciding        int
+	DecisionDeposit    Balance
+	PreparePeriod      uint32
+	DecisionPeriod     uint32
+	ConfirmPeriod      uint32
+	MinEnactmentPeriod uint32
+	MinApproval        Curve
+	MinSupport         Curve
+}
+
+// elapsedFraction converts blocks since deciding started to a fraction of
+// the decision period
+func (t Track) elapsedFraction(elapsed uint32) float64 {
+	if t.DecisionPeriod == 0 {
+		return 1
+	}
+	return clampUnit(float64(elapsed) / float64(t.DecisionPeriod))
+}
+
+// ApprovalAt returns the approval required elapsed blocks into the decision period
+func (t Track) ApprovalAt(elapsed uint32) float64 {
+	return t.MinApproval.Threshold(t.elapsedFraction(elapsed))
+}
+
+// SupportAt returns the support required elapsed blocks into the decision period
+func (t Track) SupportAt(elapsed uint32) float64 {
+	return t.MinSupport.Threshold(t.elapsedFraction(elapsed))
+}
+
+// trackSpec is a track in the runtime's units: deposits in whole tokens
+// scaled by unit, periods in blocks
+type trackSpec struct {
+	id                                    int
+	name, origin                          string
+	maxDeciding                           int
+	deposit                               int64
+	prepare, decision, confirm, enactment uint32
+	approval, support                     Curve
+}
+
+const (
+	minutes = blocksPerMinute
+	hours   = blocksPerHour
+	days    = blocksPerDay
+)
+
+// Curve points are fractions of the track's own decision period, as in the
+// runtime: (17, 28) is 17/28 of it whether the period is 28 or 14 days.
+
+// Curves of the polkadot runtime's governance/tracks.rs
+var (
+	appRoot          = reciprocalCurve(4, 28, 0.80, 0.50, 1.00)
+	supRoot          = linearCurve(28, 28, 0, 0.50)
+	appWhitelisted   = reciprocalCurve(16, 28*24, 0.96, 0.50, 1.00)
+	supWhitelisted   = reciprocalCurve(1, 28, 0.20, 0.05, 0.50)
+	appWishForChange = linearCurve(28, 28, 0.50, 1.00)
+	supWishForChange = reciprocalCurve(20, 28, 0.01, 0, 0.50)
+	appAdmin         = linearCurve(17, 28, 0.50, 1.00)
+	supAdmin         = reciprocalCurve(12, 28, 0.01, 0, 0.50)
+	appGeneralAdmin  = reciprocalCurve(4, 28, 0.80, 0.50, 1.00)
+	supGeneralAdmin  = reciprocalCurve(7, 28, 0.10, 0, 0.50)
+	appTreasurer     = reciprocalCurve(4, 28, 0.80, 0.50, 1.00)
+	supTreasurer     = linearCurve(28, 28, 0, 0.50)
+	appTipper        = linearCurve(10, 28, 0.50, 1.00)
+	supSmallTipper   = reciprocalCurve(1, 28, 0.04, 0, 0.50)
+	supBigTipper     = reciprocalCurve(8, 28, 0.01, 0, 0.50)
+	appSmallSpender  = linearCurve(17, 28, 0.50, 1.00)
+	supSmallSpender  = reciprocalCurve(12, 28, 0.01, 0, 0.50)
+	appMediumSpender = linearCurve(23, 28, 0.50, 1.00)
+	supMediumSpender = reciprocalCurve(16, 28, 0.01, 0, 0.50)
+	appBigSpender    = linearCurve(28, 28, 0.50, 1.00)
+	supBigSpender    = reciprocalCurve(20, 28, 0.01, 0, 0.50)
+)
+
+// polkadotTracks mirrors the polkadot runtime's governance/tracks.rs.
+// Deposits are in DOT.
+var polkadotTracks = []trackSpec{
+	{0, "root", "Root", 1, 100_000, 2 * hours, 28 * days, 24 * hours, 24 * hours, appRoot, supRoot},
+	{1, "whitelisted_caller", "WhitelistedCaller", 100, 10_000, 30 * minutes, 28 * days, 10 * minutes, 10 * minutes, appWhitelisted, supWhitelisted},
+	{2, "wish_for_change", "WishForChange", 10, 20_000, 2 * hours, 28 * days, 24 * hours, 10 * minutes, appWishForChange, supWishForChange},
+	{10, "staking_admin", "StakingAdmin", 10, 5_000, 2 * hours, 28 * days, 3 * hours, 10 * minutes, appAdmin, supAdmin},
+	{11, "treasurer", "Treasurer", 10, 1_000, 2 * hours, 28 * days, 7 * days, 24 * hours, appTreasurer, supTreasurer},
+	{12, "lease_admin", "LeaseAdmin", 10, 5_000, 2 * hours, 28 * days, 3 * hours, 10 * minutes, appAdmin, supAdmin},
+	{13, "fellowship_admin", "FellowshipAdmin", 10, 5_000, 2 * hours, 28 * days, 3 * hours, 10 * minutes, appAdmin, supAdmin},
+	{14, "general_admin", "GeneralAdmin", 10, 5_000, 2 * hours, 28 * days, 3 * hours, 10 * minutes, appGeneralAdmin, supGeneralAdmin},
+	{15, "auction_admin", "AuctionAdmin", 10, 5_000, 2 * hours, 28 * days, 3 * hours, 10 * minutes, appGeneralAdmin, supGeneralAdmin},
+	{20, "referendum_canceller", "ReferendumCanceller", 1_000, 10_000, 2 * hours, 7 * days, 3 * hours, 10 * minutes, appAdmin, supAdmin},
+	{21, "referendum_killer", "ReferendumKiller", 1_000, 50_000, 2 * hours, 28 * days, 3 * hours, 10 * minutes, appAdmin, supAdmin},
+	{30, "small_tipper", "SmallTipper", 200, 1, 1 * minutes, 7 * days, 10 * minutes, 1 * minutes, appTipper, supSmallTipper},
+	{31, "big_tipper", "BigTipper", 100, 10, 10 * minutes, 7 * days, 1 * hours, 10 * minutes, appTipper, supBigTipper},
+	{32, "small_spender", "SmallSpender", 50, 100, 4 * hours, 28 * days, 12 * hours, 24 * hours, appSmallSpender, supSmallSpender},
+	{33, "medium_spender", "MediumSpender", 50, 200, 4 * hours, 28 * days, 24 * hours, 24 * hours, appMediumSpender, supMediumSpender},
+	{34, "big_spender", "BigSpender", 50, 400, 4 * hours, 28 * days, 48 * hours, 24 * hours, appBigSpender, supBigSpender},
+}
+
+// Curves of the kusama runtime's governance/tracks.rs. Root, whitelisted
+// caller and wish for change are defined over 14 days; spenders pass 50%
+// approval earlier than on Polkadot.
+var (
+	kusamaAppRoot          = reciprocalCurve(4, 14, 0.80, 0.50, 1.00)
+	kusamaSupRoot          = linearCurve(14, 14, 0, 0.50)
+	kusamaAppWhitelisted   = reciprocalCurve(16, 14*24, 0.96, 0.50, 1.00)
+	kusamaSupWhitelisted   = reciprocalCurve(1, 14*24, 0.01, 0, 0.02)
+	kusamaAppWishForChange = reciprocalCurve(4, 14, 0.80, 0.50, 1.00)
+	kusamaSupWishForChange = linearCurve(14, 14, 0, 0.50)
+	kusamaAppAdmin         = linearCurve(17, 28, 0.50, 1.00)
+	kusamaSupAdmin         = reciprocalCurve(12, 28, 0.01, 0, 0.50)
+	kusamaAppGeneralAdmin  = reciprocalCurve(4, 28, 0.80, 0.50, 1.00)
+	kusamaSupGeneralAdmin  = reciprocalCurve(7, 28, 0.10, 0, 0.50)
+	kusamaAppTreasurer     = reciprocalCurve(4, 28, 0.80, 0.50, 1.00)
+	kusamaSupTreasurer     = linearCurve(28, 28, 0, 0.50)
+	kusamaAppTipper        = linearCurve(10, 28, 0.50, 1.00)
+	kusamaSupSmallTipper   = reciprocalCurve(1, 28, 0.04, 0, 0.50)
+	kusamaSupBigTipper     = reciprocalCurve(8, 28, 0.01, 0, 0.50)
+	kusamaAppSmallSpender  = linearCurve(10, 28, 0.50, 1.00)
+	kusamaSupSmallSpender  = reciprocalCurve(8, 28, 0.01, 0, 0.50)
+	kusamaAppMediumSpender = linearCurve(17, 28, 0.50, 1.00)
+	kusamaSupMediumSpender = reciprocalCurve(12, 28, 0.01, 0, 0.50)
+	kusamaAppBigSpender    = linearCurve(28, 28, 0.50, 1.00)
+	kusamaSupBigSpender    = reciprocalCurve(20, 28, 0.01, 0, 0.50)
+)
+
+// kusamaTracks mirrors the kusama runtime's governance/tracks.rs. Deposits
+// are in QUID (1/30 KSM).
+var kusamaTracks = []trackSpec{
+	{0, "root", "Root", 1, 100_000, 2 * hours, 14 * days, 24 * hours, 24 * hours, kusamaAppRoot, kusamaSupRoot},
+	{1, "whitelisted_caller", "WhitelistedCaller", 100, 10_000, 30 * minutes, 14 * days, 10 * minutes, 10 * minutes, kusamaAppWhitelisted, kusamaSupWhitelisted},
+	{2, "wish_for_change", "WishForChange", 10, 20_000, 2 * hours, 14 * days, 24 * hours, 10 * minutes, kusamaAppWishForChange, kusamaSupWishForChange},
+	{10, "staking_admin", "StakingAdmin", 10, 5_000, 2 * hours, 14 * days, 3 * hours, 10 * minutes, kusamaAppAdmin, kusamaSupAdmin},
+	{11, "treasurer", "Treasurer", 10, 1_000, 2 * hours, 14 * days, 3 * hours, 24 * hours, kusamaAppTreasurer, kusamaSupTreasurer},
+	{12, "lease_admin", "LeaseAdmin", 10, 5_000, 2 * hours, 14 * days, 3 * hours, 10 * minutes, kusamaAppAdmin, kusamaSupAdmin},
+	{13, "fellowship_admin", "FellowshipAdmin", 10, 5_000, 2 * hours, 14 * days, 3 * hours, 10 * minutes, kusamaAppAdmin, kusamaSupAdmin},
+	{14, "general_admin", "GeneralAdmin", 10, 5_000, 2 * hours, 14 * days, 3 * hours, 10 * minutes, kusamaAppGeneralAdmin, kusamaSupGeneralAdmin},
+	{15, "auction_admin", "AuctionAdmin", 10, 5_000, 2 * hours, 14 * days, 3 * hours, 10 * minutes, kusamaAppGeneralAdmin, kusamaSupGeneralAdmin},
+	{20, "referendum_canceller", "ReferendumCanceller", 1_000, 10_000, 2 * hours, 7 * days, 3 * hours, 10 * minutes, kusamaAppAdmin, kusamaSupAdmin},
+	{21, "referendum_killer", "ReferendumKiller", 1_000, 50_000, 2 * hours, 14 * days, 3 * hours, 10 * minutes, kusamaAppAdmin, kusamaSupAdmin},
+	{30, "small_tipper", "SmallTipper", 200, 1, 1 * minutes, 7 * days, 10 * minutes, 1 * minutes, kusamaAppTipper, kusamaSupSmallTipper},
+	{31, "big_tipper", "BigTipper", 100, 10, 10 * minutes, 7 * days, 1 * hours, 10 * minutes, kusamaAppTipper, kusamaSupBigTipper},
+	{32, "small_spender", "SmallSpender", 50, 100, 4 * hours, 14 * days, 12 * hours, 24 * hours, kusamaAppSmallSpender, kusamaSupSmallSpender},
+	{33, "medium_spender", "MediumSpender", 50, 200, 4 * hours, 14 * days, 24 * hours, 24 * hours, kusamaAppMediumSpender, kusamaSupMediumSpender},
+	{34, "big_spender", "BigSpender", 50, 400, 4 * hours, 14 * days, 48 * hours, 24 * hours, kusamaAppBigSpender, kusamaSupBigSpender},
+}
+
+// trackTables maps each network to its track specs and the planck value of
+// one deposit unit in that table
+var trackTables = map[string]struct {
+	specs []trackSpec
+	unit  int64
+}{
+	"polkadot": {polkadotTracks, 10_000_000_000}, // 1 DOT
+	"kusama":   {kusamaTracks, 33_333_333_333},   // 1 QUID
+	// Westend's tracks.rs has the Kusama tracks, but its GRAND is 1000 WND
+	// rather than 1000 QUID
+	"westend": {kusamaTracks, 1_000_000_000_000}, // 1 WND
+}
+
+// Tracks returns the OpenGov tracks of a network ordered by ID
+func Tracks(network string) ([]Track, error) {
+	table, ok := trackTables[strings.ToLower(network)]
+	if !ok {
+		return nil, fmt.Errorf("%w: no track registry for network %q", ErrValidation, network)
+	}
+
+	tracks := make([]Track, 0, len(table.specs))
+	for _, s := range table.specs {
+		deposit := new(big.Int).Mul(big.NewInt(s.deposit), big.NewInt(table.unit))
+		tracks = append(tracks, Track{
+			ID:                 s.id,
+			Name:               s.name,
+			Origin:             s.origin,
+			MaxDeciding:        s.maxDeciding,
+			DecisionDeposit:    Balance{v: deposit},
+			PreparePeriod:      s.prepare,
+			DecisionPeriod:     s.decision,
+			ConfirmPeriod:      s.confirm,
+			MinEnactmentPeriod: s.enactment,
+			MinApproval:        s.approval,
+			MinSupport:         s.support,
+		})
+	}
+	sort.Slice(tracks, func(i, j int) bool { return tracks[i].ID < tracks[j].ID })
+	return tracks, nil
+}
+
+// TrackByID returns a track of a network, e.g. for Post.TrackNumber
+func TrackByID(network string, id int) (Track, error) {
+	tracks, err := Tracks(network)
+	if err != nil {
+		return Track{}, err
+	}
+	for _, t := range tracks {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return Track{}, fmt.Errorf("%w: %s has no track %d", ErrValidation, network, id)
+}
+
+// TrackByOrigin returns the track of a network with the given origin, e.g.
+// for OnChainInfo.Origin. Matching ignores case and underscores, so both
+// "SmallSpender" and "small_spender" work.
+func TrackByOrigin(network, origin string) (Track, error) {
+	tracks, err := Tracks(network)
+	if err != nil {
+		return Track{}, err
+	}
+	key := strings.ReplaceAll(origin, "_", "")
+	for _, t := range tracks {
+		if strings.EqualFold(t.Origin, key) {
+			return t, nil
+		}
+	}
+	return Track{}, fmt.Errorf("%w: %s has no track with origin %q", ErrValidation, network, origin)
+}
+
+// Track returns a track of the client's network
+func (c *Client) Track(id int) (Track, error) {
+	return TrackByID(c.Network(), id)
+}
@@ -0,0 +1,147 @@
+package polkassembly
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func near(a, b float64) bool { return math.Abs(a-b) < 1e-6 }
+
+func TestReciprocalCurveMatchesRuntime(t *testing.T) {
+	// Perbill values of APP_ROOT in the polkadot runtime
+	c := reciprocalCurve(4, 28, 0.80, 0.50, 1.00)
+	if !near(c.Factor, 0.222222224) || !near(c.XOffset, 0.333333335) || !near(c.YOffset, 0.333333332) {
+		t.Errorf("unexpected curve %+v", c)
+	}
+	if !near(c.Threshold(0), 1) || !near(c.Threshold(4.0/28), 0.8) || !near(c.Threshold(1), 0.5) {
+		t.Errorf("thresholds: %v %v %v", c.Threshold(0), c.Threshold(4.0/28), c.Threshold(1))
+	}
+	if d := c.Delay(0.8); !near(d, 4.0/28) {
+		t.Errorf("delay of 80%%: got %v", d)
+	}
+	if d := c.Delay(0.4); d != 1 {
+		t.Errorf("delay below floor: got %v", d)
+	}
+}
+
+func TestLinearCurve(t *testing.T) {
+	c := linearCurve(17, 28, 0.50, 1.00)
+	if !near(c.Threshold(0), 1) || !near(c.Threshold(17.0/28), 0.5) || !near(c.Threshold(0.9), 0.5) {
+		t.Errorf("thresholds: %v %v %v", c.Threshold(0), c.Threshold(17.0/28), c.Threshold(0.9))
+	}
+	if d := c.Delay(0.75); !near(d, 17.0/56) {
+		t.Errorf("delay of 75%%: got %v", d)
+	}
+}
+
+func TestTrackRegistry(t *testing.T) {
+	for _, network := range []string{"polkadot", "kusama", "westend"} {
+		tracks, err := Tracks(network)
+		if err != nil || len(tracks) == 0 {
+			t.Fatalf("%s: %v", network, err)
+		}
+		for i := 1; i < len(tracks); i++ {
+			if tracks[i-1].ID >= tracks[i].ID {
+				t.Errorf("%s: tracks not ordered by ID", network)
+			}
+		}
+	}
+
+	spender, err := TrackByID("polkadot", 33)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spender.Name != "medium_spender" || spender.DecisionDeposit.String() != "2000000000000" ||
+		BlocksToDuration(spender.DecisionPeriod).Hours() != 28*24 {
+		t.Errorf("unexpected track %+v", spender)
+	}
+	if !near(spender.ApprovalAt(0), 1) || !near(spender.ApprovalAt(spender.DecisionPeriod), 0.5) {
+		t.Errorf("approval: %v %v", spender.ApprovalAt(0), spender.ApprovalAt(spender.DecisionPeriod))
+	}
+	if !near(spender.SupportAt(0), 0.5) || spender.SupportAt(spender.DecisionPeriod) > 0.0001 {
+		t.Errorf("support: %v %v", spender.SupportAt(0), spender.SupportAt(spender.DecisionPeriod))
+	}
+
+	root, err := TrackByOrigin("kusama", "root")
+	if err != nil || root.ID != 0 || root.DecisionDeposit.Format(Token{Symbol: "KSM", Decimals: 12}) != "3333.3333333 KSM" {
+		t.Errorf("kusama root: %+v, %v", root, err)
+	}
+	big, err := TrackByOrigin("westend", "BigSpender")
+	if err != nil || big.DecisionDeposit.Format(Token{Symbol: "WND", Decimals: 12}) != "400 WND" {
+		t.Errorf("westend big spender: %+v, %v", big, err)
+	}
+	root, err = TrackByID("westend", 0)
+	if err != nil || root.DecisionDeposit.String() != "100000000000000000" {
+		t.Errorf("westend root deposit: %v, %v", root.DecisionDeposit, err)
+	}
+	if _, err := TrackByOrigin("polkadot", "small_spender"); err != nil {
+		t.Errorf("snake case origin: %v", err)
+	}
+	if _, err := TrackByID("polkadot", 99); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation, got %v", err)
+	}
+}
+
+func TestTrackCurvesMatchRuntime(t *testing.T) {
+	// Approval and support at 0%, 50% and 100% of each decision period, as
+	// computed by pallet_referenda's Curve for the runtimes' tracks.rs
+	type point struct{ approval, support [3]float64 }
+	var (
+		root          = point{[3]float64{1, 0.6, 0.5}, [3]float64{0.5, 0.25, 0}}
+		whitelisted   = point{[3]float64{1, 0.609524, 0.5}, [3]float64{0.5, 0.058182, 0.05}}
+		wishForChange = point{[3]float64{1, 0.75, 0.5}, [3]float64{0.5, 0.024272, 0}}
+		admin         = point{[3]float64{1, 0.588235, 0.5}, [3]float64{0.5, 0.007538, 0}}
+		generalAdmin  = point{[3]float64{1, 0.6, 0.5}, [3]float64{0.5, 0.038462, 0}}
+		treasurer     = point{[3]float64{1, 0.6, 0.5}, [3]float64{0.5, 0.25, 0}}
+		smallTipper   = point{[3]float64{1, 0.5, 0.5}, [3]float64{0.5, 0.001605, 0}}
+		bigTipper     = point{[3]float64{1, 0.5, 0.5}, [3]float64{0.5, 0.004049, 0}}
+		smallSpender  = point{[3]float64{1, 0.588235, 0.5}, [3]float64{0.5, 0.007538, 0}}
+		mediumSpender = point{[3]float64{1, 0.695652, 0.5}, [3]float64{0.5, 0.013245, 0}}
+		bigSpender    = point{[3]float64{1, 0.75, 0.5}, [3]float64{0.5, 0.024272, 0}}
+
+		kusamaRoot         = point{[3]float64{1, 0.6875, 0.5}, [3]float64{0.5, 0.25, 0}}
+		kusamaWhitelisted  = point{[3]float64{1, 0.68254, 0.5}, [3]float64{0.02, 0.00006, 0}}
+		kusamaSmallSpender = point{[3]float64{1, 0.5, 0.5}, [3]float64{0.5, 0.004049, 0}}
+		kusamaMedium       = point{[3]float64{1, 0.588235, 0.5}, [3]float64{0.5, 0.007538, 0}}
+	)
+	tests := []struct {
+		network string
+		want    map[int]point
+	}{
+		{"polkadot", map[int]point{
+			0: root, 1: whitelisted, 2: wishForChange, 10: admin, 11: treasurer, 12: admin,
+			13: admin, 14: generalAdmin, 15: generalAdmin, 20: admin, 21: admin,
+			30: smallTipper, 31: bigTipper, 32: smallSpender, 33: mediumSpender, 34: bigSpender,
+		}},
+		{"kusama", map[int]point{
+			0: kusamaRoot, 1: kusamaWhitelisted, 2: kusamaRoot, 10: admin, 11: treasurer, 12: admin,
+			13: admin, 14: generalAdmin, 15: generalAdmin, 20: admin, 21: admin,
+			30: smallTipper, 31: bigTipper, 32: kusamaSmallSpender, 33: kusamaMedium, 34: bigSpender,
+		}},
+	}
+	for _, tt := range tests {
+		tracks, err := Tracks(tt.network)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tracks) != len(tt.want) {
+			t.Errorf("%s: got %d tracks, want %d", tt.network, len(tracks), len(tt.want))
+		}
+		for _, tr := range tracks {
+			want, ok := tt.want[tr.ID]
+			if !ok {
+				t.Errorf("%s: unexpected track %d", tt.network, tr.ID)
+				continue
+			}
+			for i, elapsed := range []uint32{0, tr.DecisionPeriod / 2, tr.DecisionPeriod} {
+				if got := tr.ApprovalAt(elapsed); math.Abs(got-want.approval[i]) > 1e-5 {
+					t.Errorf("%s %s approval at %d: got %.6f, want %.6f", tt.network, tr.Name, elapsed, got, want.approval[i])
+				}
+				if got := tr.SupportAt(elapsed); math.Abs(got-want.support[i]) > 1e-5 {
+					t.Errorf("%s %s support at %d: got %.6f, want %.6f", tt.network, tr.Name, elapsed, got, want.support[i])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package polkassembly
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// treasuryTracks are the OpenGov tracks whose referenda spend treasury funds:
+// Treasurer, SmallTipper, BigTipper, SmallSpender, MediumSpender, BigSpender.
+var treasuryTracks = []int{11, 30, 31, 32, 33, 34}
+
+// GetTreasuryProposals retrieves legacy treasury proposals
+func (c *Client) GetTreasuryProposals(params TreasuryProposalListingParams) (*TreasuryProposalListingResponse, error) {
+	return c.GetTreasuryProposalsCtx(context.Background(), params)
+}
+
+// GetTreasuryProposalsCtx is like GetTreasuryProposals but carries ctx for cancellation and deadlines
+func (c *Client) GetTreasuryProposalsCtx(ctx context.Context, params TreasuryProposalListingParams) (*TreasuryProposalListingResponse, error) {
+	posts, err := c.GetPostsCtx(ctx, PostListingParams{
+		Page:         params.Page,
+		ListingLimit: params.Limit,
+		TrackStatus:  params.Status,
+		ProposalType: ProposalTypeTreasuryProposal,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &TreasuryProposalListingResponse{TotalCount: posts.TotalCount}
+	for _, post := range posts.Posts {
+		resp.Proposals = append(resp.Proposals, treasuryProposalFromPost(post))
+	}
+
+	return resp, nil
+}
+
+// GetTreasurySpends retrieves OpenGov referenda on the treasury tracks.
+// When params.TrackNo is zero every treasury track is listed in full, the
+// referenda are merged newest first and params.Page and params.Limit select
+// a page of the merged list; a zero Limit returns all of them.
+func (c *Client) GetTreasurySpends(params TreasuryProposalListingParams) (*TreasuryProposalListingResponse, error) {
+	return c.GetTreasurySpendsCtx(context.Background(), params)
+}
+
+// GetTreasurySpendsCtx is like GetTreasurySpends but carries ctx for cancellation and deadlines
+func (c *Client) GetTreasurySpendsCtx(ctx context.Context, params TreasuryProposalListingParams) (*TreasuryProposalListingResponse, error) {
+	if params.TrackNo > 0 {
+		posts, err := c.GetPostsCtx(ctx, PostListingParams{
+			Page:         params.Page,
+			ListingLimit: params.Limit,
+			TrackNo:      params.TrackNo,
+			TrackStatus:  params.Status,
+			ProposalType: ProposalTypeReferendumV2,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &TreasuryProposalListingResponse{TotalCount: posts.TotalCount}
+		for _, post := range posts.Posts {
+			resp.Proposals = append(resp.Proposals, treasurySpendFromPost(post, params.TrackNo))
+		}
+		return resp, nil
+	}
+
+	var all []TreasuryProposal
+	for _, track := range treasuryTracks {
+		listing := PostListingParams{TrackNo: track, TrackStatus: params.Status, ProposalType: ProposalTypeReferendumV2}
+		for post, err := range c.AllPosts(ctx, listing, PageOptions{PageSize: 100}) {
+			if err != nil {
+				return nil, fmt.Errorf("track %d: %w", track, err)
+			}
+			all = append(all, treasurySpendFromPost(post, track))
+		}
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		if !all[i].CreatedAt.Equal(all[j].CreatedAt) {
+			return all[i].CreatedAt.After(all[j].CreatedAt)
+		}
+		return all[i].ProposalID > all[j].ProposalID
+	})
+
+	resp := &TreasuryProposalListingResponse{TotalCount: len(all), Proposals: all}
+	if params.Limit > 0 {
+		from := min(max(params.Page-1, 0)*params.Limit, len(all))
+		resp.Proposals = all[from:min(from+params.Limit, len(all))]
+	}
+	return resp, nil
+}
+
+func treasurySpendFromPost(post Post, track int) TreasuryProposal {
+	if post.TrackNumber == 0 {
+		post.TrackNumber = track
+	}
+	return treasuryProposalFromPost(post)
+}
+
+// GetTreasuryProposal retrieves a single legacy treasury proposal
+func (c *Client) GetTreasuryProposal(proposalID int) (*TreasuryProposal, error) {
+	return c.GetTreasuryProposalCtx(context.Background(), proposalID)
+}
+
+// GetTreasuryProposalCtx is like GetTreasuryProposal but carries ctx for cancellation and deadlines
+func (c *Client) GetTreasuryProposalCtx(ctx context.Context, proposalID int) (*TreasuryProposal, error) {
+	post, err := c.GetPostByTypeCtx(ctx, proposalID, ProposalTypeTreasuryProposal)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := treasuryProposalFromPost(*post)
+	return &proposal, nil
+}
+
+// GetTreasurySpend retrieves a treasury spend referendum by index
+func (c *Client) GetTreasurySpend(referendumIndex int) (*TreasuryProposal, error) {
+	return c.GetTreasurySpendCtx(context.Background(), referendumIndex)
+}
+
+// GetTreasurySpendCtx is like GetTreasurySpend but carries ctx for cancellation and deadlines
+func (c *Client) GetTreasurySpendCtx(ctx context.Context, referendumIndex int) (*TreasuryProposal, error) {
+	post, err := c.GetPostByTypeCtx(ctx, referendumIndex, ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := treasuryProposalFromPost(*post)
+	return &proposal, nil
+}
+
+// CreateTreasuryProposal creates the discussion post for a treasury proposal
+func (c *Client) CreateTreasuryProposal(req CreateTreasuryProposalRequest) (*TreasuryProposal, error) {
+	return c.CreateTreasuryProposalCtx(context.Background(), req)
+}
+
+// CreateTreasuryProposalCtx is like CreateTreasuryProposal but carries ctx for cancellation and deadlines
+func (c *Client) CreateTreasuryProposalCtx(ctx context.Context, req CreateTreasuryProposalRequest) (*TreasuryProposal, error) {
+	if req.Beneficiary == "" {
+		return nil, fmt.Errorf("%w: beneficiary is required", ErrValidation)
+	}
+	if req.Value.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: value must be positive", ErrValidation)
+	}
+
+	r, err := c.newRequest(ctx).
+		SetBody(req).
+		Post("/TreasuryProposal")
+	if err != nil {
+		return nil, err
+	}
+
+	var post Post
+	if err := c.parseResponse(r, &post); err != nil {
+		return nil, err
+	}
+
+	proposal := treasuryProposalFromPost(post)
+	if proposal.Value.IsZero() {
+		proposal.Value = req.Value
+	}
+	if proposal.Beneficiary == "" {
+		proposal.Beneficiary = req.Beneficiary
+	}
+	if proposal.Title == "" {
+		proposal.Title = req.Title
+	}
+
+	return &proposal, nil
+}
+
+// treasuryProposalFromPost maps a generic post onto a TreasuryProposal.
+// Value is the total paid in the first beneficiary's asset; Bond is the
+// legacy proposal bond or else the referendum's deposits.
+func treasuryProposalFromPost(post Post) TreasuryProposal {
+	index := post.Index
+	if index == 0 {
+		index = post.PostID
+	}
+
+	p := TreasuryProposal{
+		ProposalID:   index,
+		Proposer:     post.ProposerAddress,
+		Status:       post.Status,
+		CreatedAt:    post.CreatedAt,
+		Title:        post.Title,
+		ProposalType: post.ProposalType,
+		TrackNumber:  post.TrackNumber,
+	}
+
+	info := post.OnChainInfo
+	if info == nil {
+		return p
+	}
+
+	if info.Proposer != "" {
+		p.Proposer = info.Proposer
+	}
+	if info.Status != "" {
+		p.Status = info.Status
+	}
+	if !info.CreatedAt.IsZero() {
+		p.CreatedAt = info.CreatedAt
+	}
+	p.Origin = info.Origin
+	p.Bond = info.Bond
+	if p.Bond.IsZero() {
+		p.Bond = info.SubmissionDeposit.Add(info.DecisionDeposit)
+	}
+
+	for i, b := range info.Beneficiaries {
+		b.AssetID = normalizeAssetID(b.AssetID)
+		p.Beneficiaries = append(p.Beneficiaries, b)
+
+		if i == 0 {
+			p.AssetID = b.AssetID
+			p.Beneficiary = b.Address
+		}
+		if b.AssetID == p.AssetID {
+			p.Value = p.Value.Add(b.Amount)
+		}
+	}
+
+	return p
+}
+
+// normalizeAssetID maps the various spellings of the native asset to ""
+func normalizeAssetID(id string) string {
+	id = strings.TrimSpace(id)
+	switch strings.ToLower(id) {
+	case "", "null", "native":
+		return ""
+	}
+	return id
+}
@@ -0,0 +1,133 @@
+package polkassembly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestTreasuryProposalFromPost(t *testing.T) {
+	var post Post
+	err := json.Unmarshal([]byte(`{
+		"index": 1200, "title": "Spend", "proposer": "listing-proposer", "status": "Submitted",
+		"onChainInfo": {
+			"proposer": "chain-proposer", "status": "Deciding", "origin": "BigSpender",
+			"submissionDeposit": "10000000000", "decisionDeposit": "4000000000000",
+			"beneficiaries": [
+				{"address": "alice", "amount": "100", "assetId": "native"},
+				{"address": "bob", "amount": "0x32", "assetId": "null"},
+				{"address": "carol", "amount": "7", "assetId": " 1984 "},
+				{"address": "dave", "amount": "25"}
+			]
+		}
+	}`), &post)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := treasuryProposalFromPost(post)
+	if p.ProposalID != 1200 || p.Proposer != "chain-proposer" || p.Status != "Deciding" || p.Origin != "BigSpender" {
+		t.Errorf("unexpected proposal %+v", p)
+	}
+	if p.Beneficiary != "alice" || p.AssetID != "" {
+		t.Errorf("first beneficiary: got %q in asset %q", p.Beneficiary, p.AssetID)
+	}
+	// Native amounts are summed; the USDT payout is listed but not added
+	if p.Value.String() != "175" {
+		t.Errorf("value: got %s, want 175", p.Value)
+	}
+	if p.Bond.String() != "4010000000000" {
+		t.Errorf("bond: got %s, want the sum of both deposits", p.Bond)
+	}
+	if len(p.Beneficiaries) != 4 || p.Beneficiaries[1].AssetID != "" || p.Beneficiaries[2].AssetID != "1984" {
+		t.Errorf("asset IDs not normalized: %+v", p.Beneficiaries)
+	}
+
+	legacy := Post{PostID: 12, OnChainInfo: &OnChainInfo{Bond: NewBalanceInt64(500), DecisionDeposit: NewBalanceInt64(1)}}
+	if p := treasuryProposalFromPost(legacy); p.Bond.String() != "500" {
+		t.Errorf("legacy bond: got %s, want 500", p.Bond)
+	}
+
+	p = treasuryProposalFromPost(Post{PostID: 7, Status: "Proposed"})
+	if p.ProposalID != 7 || p.Status != "Proposed" || !p.Value.IsZero() {
+		t.Errorf("post without on-chain info: %+v", p)
+	}
+}
+
+func TestGetTreasurySpendsMergesTracks(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		track, _ := strconv.Atoi(r.URL.Query().Get("trackNo"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		// Two referenda per treasury track, spread over time across tracks
+		var items []Post
+		if page == 1 {
+			for i := range 2 {
+				index := track*10 + i
+				items = append(items, Post{Index: index, CreatedAt: base.Add(time.Duration(i*100+track) * time.Hour)})
+			}
+		}
+		json.NewEncoder(w).Encode(PostListingResponse{Items: items, TotalCount: 2})
+	}))
+	defer srv.Close()
+
+	c := NewClient(Config{BaseURL: srv.URL, Network: "polkadot"})
+
+	seen := make(map[int]bool)
+	var last time.Time
+	for page := 1; ; page++ {
+		resp, err := c.GetTreasurySpends(TreasuryProposalListingParams{Page: page, Limit: 5})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.TotalCount != 12 {
+			t.Fatalf("total: got %d, want 12", resp.TotalCount)
+		}
+		if len(resp.Proposals) == 0 {
+			break
+		}
+		if len(resp.Proposals) > 5 {
+			t.Fatalf("page %d has %d items", page, len(resp.Proposals))
+		}
+		for _, p := range resp.Proposals {
+			if seen[p.ProposalID] {
+				t.Errorf("referendum %d repeated", p.ProposalID)
+			}
+			seen[p.ProposalID] = true
+			if !last.IsZero() && p.CreatedAt.After(last) {
+				t.Errorf("referendum %d out of order", p.ProposalID)
+			}
+			last = p.CreatedAt
+			if p.TrackNumber != p.ProposalID/10 {
+				t.Errorf("referendum %d: track %d", p.ProposalID, p.TrackNumber)
+			}
+		}
+	}
+	if len(seen) != 12 {
+		t.Errorf("got %d referenda, want 12", len(seen))
+	}
+
+	all, err := c.GetTreasurySpendsCtx(context.Background(), TreasuryProposalListingParams{})
+	if err != nil || len(all.Proposals) != 12 {
+		t.Errorf("zero limit: got %d, %v", len(all.Proposals), err)
+	}
+}
+
+func TestCreateTreasuryProposalValidation(t *testing.T) {
+	c := NewClient(Config{BaseURL: "http://127.0.0.1:0", Network: "polkadot"})
+	for _, req := range []CreateTreasuryProposalRequest{
+		{Value: NewBalanceInt64(1)},
+		{Beneficiary: "14E5nqKAp3oAJcmzgZhUD2RcptBeUBScxKHgJKU4HPNcKVf3"},
+		{Beneficiary: "14E5nqKAp3oAJcmzgZhUD2RcptBeUBScxKHgJKU4HPNcKVf3", Value: NewBalanceInt64(-1)},
+	} {
+		if _, err := c.CreateTreasuryProposal(req); !errors.Is(err, ErrValidation) {
+			t.Errorf("%+v: expected ErrValidation, got %v", req, err)
+		}
+	}
+}
@@ -2,19 +2,6 @@ package polkassembly
 
 import "time"
 
-// Common types
-type APIError struct {
-	ErrorMessage string `json:"error"`
-	Message      string `json:"message"`
-}
-
-func (e *APIError) Error() string {
-	if e.Message != "" {
-		return e.Message
-	}
-	return e.ErrorMessage
-}
-
 // Auth types
 type Web3AuthRequest struct {
 	Address   string `json:"address"`
@@ -86,14 +73,14 @@ type PublicSocialLink struct {
 
 // Post types
 type PostListingParams struct {
-	Page         int    `json:"page,omitempty"`
-	ListingLimit int    `json:"listingLimit,omitempty"`
-	TrackNo      int    `json:"trackNo,omitempty"`
-	TrackStatus  string `json:"trackStatus,omitempty"`
-	ProposalType string `json:"proposalType,omitempty"`
-	SortBy       string `json:"sortBy,omitempty"`
-	SearchTerm   string `json:"searchTerm,omitempty"`
-	Origin       string `json:"origin,omitempty"`
+	Page         int          `json:"page,omitempty"`
+	ListingLimit int          `json:"listingLimit,omitempty"`
+	TrackNo      int          `json:"trackNo,omitempty"`
+	TrackStatus  string       `json:"trackStatus,omitempty"`
+	ProposalType ProposalType `json:"proposalType,omitempty"`
+	SortBy       string       `json:"sortBy,omitempty"`
+	SearchTerm   string       `json:"searchTerm,omitempty"`
+	Origin       string       `json:"origin,omitempty"`
 }
 
 type PostListingResponse struct {
@@ -113,7 +100,7 @@ type Post struct {
 	CreatedAt        time.Time    `json:"createdAt"`
 	UpdatedAt        time.Time    `json:"updatedAt"`
 	PostType         string       `json:"post_type,omitempty"` // Legacy field
-	ProposalType     string       `json:"proposalType"`        // Actual field
+	ProposalType     ProposalType `json:"proposalType"`        // Actual field
 	Status           string       `json:"status,omitempty"`
 	ProposerAddress  string       `json:"proposer,omitempty"`
 	CommentsCount    int          `json:"comments_count,omitempty"`
@@ -131,6 +118,7 @@ type Post struct {
 	IsDeleted        bool         `json:"isDeleted"`
 	IsDefaultContent bool         `json:"isDefaultContent"`
 	Tags             []string     `json:"tags"`
+	TopicID          int          `json:"topicId,omitempty"` // Off-chain posts only
 	Metrics          PostMetrics  `json:"metrics"`
 	OnChainInfo      *OnChainInfo `json:"onChainInfo,omitempty"`
 	PublicUser       *PublicUser  `json:"publicUser,omitempty"`
@@ -157,29 +145,45 @@ type OnChainInfo struct {
 	DecisionPeriodEndsAt time.Time     `json:"decisionPeriodEndsAt,omitempty"`
 	PreparePeriodEndsAt  time.Time     `json:"preparePeriodEndsAt,omitempty"`
 	Beneficiaries        []Beneficiary `json:"beneficiaries,omitempty"`
+	// Bond is the bond of a legacy treasury proposal
+	Bond Balance `json:"bond"`
+	// SubmissionDeposit and DecisionDeposit are the deposits placed on an
+	// OpenGov referendum
+	SubmissionDeposit Balance `json:"submissionDeposit"`
+	DecisionDeposit   Balance `json:"decisionDeposit"`
+	// StatusHistory lists every status the proposal went through, oldest
+	// first
+	StatusHistory []StatusChange `json:"statusHistory,omitempty"`
+}
+
+// StatusChange is one entry of a proposal's on-chain status history
+type StatusChange struct {
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+	Block     int       `json:"block,omitempty"`
 }
 
 type VoteMetrics struct {
 	Nay struct {
-		Count int    `json:"count"`
-		Value string `json:"value"`
+		Count int     `json:"count"`
+		Value Balance `json:"value"`
 	} `json:"nay"`
 	Aye struct {
-		Count int    `json:"count"`
-		Value string `json:"value"`
+		Count int     `json:"count"`
+		Value Balance `json:"value"`
 	} `json:"aye"`
 	Support struct {
-		Value string `json:"value"`
+		Value Balance `json:"value"`
 	} `json:"support"`
 	BareAyes struct {
-		Value string `json:"value"`
+		Value Balance `json:"value"`
 	} `json:"bareAyes"`
 }
 
 type Beneficiary struct {
-	Address string `json:"address"`
-	Amount  string `json:"amount"`
-	AssetID string `json:"assetId"`
+	Address string  `json:"address"`
+	Amount  Balance `json:"amount"`
+	AssetID string  `json:"assetId"`
 }
 
 type PublicUser struct {
@@ -202,25 +206,25 @@ type ProfileDetails struct {
 }
 
 type PostOnchainData struct {
-	Hash          string `json:"hash"`
-	Status        string `json:"status"`
-	AyesCount     int    `json:"ayesCount"`
-	NaysCount     int    `json:"naysCount"`
-	SupportAmount string `json:"supportAmount"`
-	AgainstAmount string `json:"againstAmount"`
-	Turnout       string `json:"turnout"`
-	Electorate    string `json:"electorate"`
-	Threshold     string `json:"threshold"`
+	Hash          string  `json:"hash"`
+	Status        string  `json:"status"`
+	AyesCount     int     `json:"ayesCount"`
+	NaysCount     int     `json:"naysCount"`
+	SupportAmount Balance `json:"supportAmount"`
+	AgainstAmount Balance `json:"againstAmount"`
+	Turnout       Balance `json:"turnout"`
+	Electorate    Balance `json:"electorate"`
+	Threshold     string  `json:"threshold"`
 }
 
 type ContentSummary struct {
-	CreatedAt    time.Time `json:"createdAt"`
-	ID           string    `json:"id"`
-	IndexOrHash  string    `json:"indexOrHash"`
-	Network      string    `json:"network"`
-	PostSummary  string    `json:"postSummary"`
-	ProposalType string    `json:"proposalType"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	CreatedAt    time.Time    `json:"createdAt"`
+	ID           string       `json:"id"`
+	IndexOrHash  string       `json:"indexOrHash"`
+	Network      string       `json:"network"`
+	PostSummary  string       `json:"postSummary"`
+	ProposalType ProposalType `json:"proposalType"`
+	UpdatedAt    time.Time    `json:"updatedAt"`
 }
 
 type Comment struct {
@@ -250,7 +254,7 @@ type ActivityFeedItem struct {
 type CreateOffchainPostRequest struct {
 	Title   string   `json:"title"`
 	Content string   `json:"content"`
-	TopicID int      `json:"topic_id,omitempty"`
+	TopicID int      `json:"topicId,omitempty"`
 	Tags    []string `json:"tags,omitempty"`
 }
 
@@ -258,6 +262,7 @@ type UpdatePostRequest struct {
 	Title   string   `json:"title,omitempty"`
 	Content string   `json:"content,omitempty"`
 	Tags    []string `json:"tags,omitempty"`
+	TopicID int      `json:"topicId,omitempty"`
 }
 
 type SubscriptionStatus struct {
@@ -265,14 +270,14 @@ type SubscriptionStatus struct {
 }
 
 type Bounty struct {
-	BountyID       int    `json:"bounty_id"`
-	Description    string `json:"description"`
-	Proposer       string `json:"proposer"`
-	Value          string `json:"value"`
-	Fee            string `json:"fee"`
-	Status         string `json:"status"`
-	CuratorDeposit string `json:"curator_deposit,omitempty"`
-	Bond           string `json:"bond,omitempty"`
+	BountyID       int     `json:"bounty_id"`
+	Description    string  `json:"description"`
+	Proposer       string  `json:"proposer"`
+	Value          Balance `json:"value"`
+	Fee            Balance `json:"fee"`
+	Status         string  `json:"status"`
+	CuratorDeposit Balance `json:"curator_deposit"`
+	Bond           Balance `json:"bond"`
 }
 
 // Vote types
@@ -285,20 +290,21 @@ type VoteListingParams struct {
 
 type VoteListingResponse struct {
 	Votes []Vote `json:"votes"`
-	Count int    `json:"count"`
+	// Count is the number of votes across all pages
+	Count int `json:"count"`
 }
 
 type Vote struct {
-	ID              string    `json:"id"`
-	Voter           string    `json:"voter"`
-	Balance         string    `json:"balance"`
-	Vote            string    `json:"vote"`
-	LockPeriod      int       `json:"lockPeriod"`
-	Decision        string    `json:"decision"`
-	CreatedAt       time.Time `json:"created_at"`
-	DelegatedTo     string    `json:"delegatedTo,omitempty"`
-	IsDelegated     bool      `json:"isDelegated"`
-	ConvictionCount int       `json:"conviction_count"`
+	ID              string     `json:"id"`
+	Voter           string     `json:"voter"`
+	Balance         Balance    `json:"balance"`
+	Vote            string     `json:"vote"`
+	LockPeriod      Conviction `json:"lockPeriod"`
+	Decision        string     `json:"decision"`
+	CreatedAt       time.Time  `json:"created_at"`
+	DelegatedTo     string     `json:"delegatedTo,omitempty"`
+	IsDelegated     bool       `json:"isDelegated"`
+	ConvictionCount int        `json:"conviction_count"`
 }
 
 type VotingCurveData struct {
@@ -310,10 +316,10 @@ type VotingCurveData struct {
 }
 
 type CreateVoteRequest struct {
-	PostID     int    `json:"postId"`
-	Vote       string `json:"vote"` // "aye" or "nay"
-	Balance    string `json:"balance,omitempty"`
-	LockPeriod int    `json:"lockPeriod,omitempty"`
+	PostID     int        `json:"postId"`
+	Vote       string     `json:"vote"` // "aye" or "nay"
+	Balance    *Balance   `json:"balance,omitempty"`
+	LockPeriod Conviction `json:"lockPeriod,omitempty"`
 }
 
 // Action types
@@ -335,21 +341,44 @@ type Reaction struct {
 }
 
 type Report struct {
-	ID         int       `json:"id"`
-	Type       string    `json:"type"`
-	ContentID  int       `json:"content_id"`
-	Reason     string    `json:"reason"`
-	Comments   string    `json:"comments"`
-	ReportedBy string    `json:"reported_by"`
-	CreatedAt  time.Time `json:"created_at"`
-	Status     string    `json:"status"`
+	ID           int          `json:"id"`
+	Type         string       `json:"type"`
+	ContentID    int          `json:"content_id"`
+	CommentID    string       `json:"comment_id,omitempty"`
+	ProposalType ProposalType `json:"proposal_type,omitempty"`
+	Reason       string       `json:"reason"`
+	Comments     string       `json:"comments"`
+	ReportedBy   string       `json:"reported_by"`
+	CreatedAt    time.Time    `json:"created_at"`
+	Status       string       `json:"status"`
+	Resolution   string       `json:"resolution,omitempty"`
+	ResolvedBy   string       `json:"resolved_by,omitempty"`
 }
 
 type CreateReportRequest struct {
-	Type      string `json:"type"`
-	ContentID int    `json:"content_id"`
-	Reason    string `json:"reason"`
-	Comments  string `json:"comments,omitempty"`
+	Type         string       `json:"type"`
+	ContentID    int          `json:"content_id"`              // Post index
+	CommentID    string       `json:"comment_id,omitempty"`    // Set when reporting a comment
+	ProposalType ProposalType `json:"proposal_type,omitempty"` // Proposal type of the post
+	Reason       string       `json:"reason"`
+	Comments     string       `json:"comments,omitempty"`
+}
+
+type ReportListingParams struct {
+	Page   int    `json:"page,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+	Status string `json:"status,omitempty"`
+	Type   string `json:"type,omitempty"`
+}
+
+type ReportListingResponse struct {
+	Reports    []Report `json:"reports"`
+	TotalCount int      `json:"totalCount"`
+}
+
+type ResolveReportRequest struct {
+	Status     string `json:"status"` // ReportStatusResolved or ReportStatusDismissed
+	Resolution string `json:"resolution,omitempty"`
 }
 
 // User types
@@ -383,7 +412,8 @@ type UserListingParams struct {
 
 type UserListingResponse struct {
 	Users []User `json:"users"`
-	Count int    `json:"count"`
+	// Count is the number of users across all pages
+	Count int `json:"count"`
 }
 
 // Preimage types
@@ -396,7 +426,7 @@ type Preimage struct {
 	Status       string      `json:"status"`
 	CreatedAt    time.Time   `json:"created_at"`
 	Author       string      `json:"author,omitempty"`
-	Deposit      string      `json:"deposit,omitempty"`
+	Deposit      Balance     `json:"deposit"`
 }
 
 type PreimageListingParams struct {
@@ -407,49 +437,50 @@ type PreimageListingParams struct {
 
 type PreimageListingResponse struct {
 	Preimages []Preimage `json:"preimages"`
-	Count     int        `json:"count"`
+	// Count is the number of preimages across all pages
+	Count int `json:"count"`
 }
 
 // Vote Cart types
 type CartItem struct {
-	ID              string     `json:"id"`
-	PostIndexOrHash string     `json:"postIndexOrHash"`
-	ProposalType    string     `json:"proposalType"`
-	Decision        string     `json:"decision"`
-	Amount          CartAmount `json:"amount"`
-	Conviction      int        `json:"conviction"`
-	Title           string     `json:"title"`
-	CreatedAt       time.Time  `json:"created_at"`
+	ID              string       `json:"id"`
+	PostIndexOrHash string       `json:"postIndexOrHash"`
+	ProposalType    ProposalType `json:"proposalType"`
+	Decision        string       `json:"decision"`
+	Amount          CartAmount   `json:"amount"`
+	Conviction      Conviction   `json:"conviction"`
+	Title           string       `json:"title"`
+	CreatedAt       time.Time    `json:"created_at"`
 }
 
 type CartAmount struct {
-	Abstain string `json:"abstain"`
-	Aye     string `json:"aye"`
-	Nay     string `json:"nay"`
+	Abstain Balance `json:"abstain"`
+	Aye     Balance `json:"aye"`
+	Nay     Balance `json:"nay"`
 }
 
 type AddCartItemRequest struct {
-	PostIndexOrHash string     `json:"postIndexOrHash"`
-	ProposalType    string     `json:"proposalType"`
-	Decision        string     `json:"decision"`
-	Amount          CartAmount `json:"amount"`
-	Conviction      int        `json:"conviction"`
-	Title           string     `json:"title"`
+	PostIndexOrHash string       `json:"postIndexOrHash"`
+	ProposalType    ProposalType `json:"proposalType"`
+	Decision        string       `json:"decision"`
+	Amount          CartAmount   `json:"amount"`
+	Conviction      Conviction   `json:"conviction"`
+	Title           string       `json:"title"`
 }
 
 type UpdateCartItemRequest struct {
 	ID         string     `json:"id"`
 	Decision   string     `json:"decision"`
 	Amount     CartAmount `json:"amount"`
-	Conviction int        `json:"conviction"`
+	Conviction Conviction `json:"conviction"`
 }
 
 // Delegation types
 type DelegationStats struct {
-	TotalDelegations  int    `json:"totalDelegations"`
-	TotalDelegates    int    `json:"totalDelegates"`
-	TotalBalance      string `json:"totalBalance"`
-	WeeklyDelegations int    `json:"weeklyDelegations"`
+	TotalDelegations  int     `json:"totalDelegations"`
+	TotalDelegates    int     `json:"totalDelegates"`
+	TotalBalance      Balance `json:"totalBalance"`
+	WeeklyDelegations int     `json:"weeklyDelegations"`
 }
 
 type Delegate struct {
@@ -475,10 +506,10 @@ type UpdatePADelegateRequest struct {
 }
 
 type TrackStats struct {
-	TrackID          int    `json:"trackId"`
-	TrackName        string `json:"trackName"`
-	DelegatedAmount  string `json:"delegatedAmount"`
-	DelegationsCount int    `json:"delegationsCount"`
+	TrackID          int     `json:"trackId"`
+	TrackName        string  `json:"trackName"`
+	DelegatedAmount  Balance `json:"delegatedAmount"`
+	DelegationsCount int     `json:"delegationsCount"`
 }
 
 type TrackLevelData struct {
@@ -489,20 +520,38 @@ type TrackLevelData struct {
 
 // Treasury types
 type TreasuryProposal struct {
-	ProposalID  int       `json:"proposal_id"`
-	Proposer    string    `json:"proposer"`
-	Value       string    `json:"value"`
-	Beneficiary string    `json:"beneficiary"`
-	Bond        string    `json:"bond"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"created_at"`
+	ProposalID    int           `json:"proposal_id"`
+	Proposer      string        `json:"proposer"`
+	Value         Balance       `json:"value"`
+	Beneficiary   string        `json:"beneficiary"`
+	Bond          Balance       `json:"bond"` // For spends, the referendum's deposits
+	Status        string        `json:"status"`
+	CreatedAt     time.Time     `json:"created_at"`
+	Title         string        `json:"title,omitempty"`
+	ProposalType  ProposalType  `json:"proposalType,omitempty"`
+	TrackNumber   int           `json:"trackNumber,omitempty"`
+	Origin        string        `json:"origin,omitempty"`
+	AssetID       string        `json:"assetId,omitempty"` // Empty for the native token
+	Beneficiaries []Beneficiary `json:"beneficiaries,omitempty"`
+}
+
+type TreasuryProposalListingParams struct {
+	Page    int    `json:"page,omitempty"`
+	Limit   int    `json:"limit,omitempty"`
+	Status  string `json:"status,omitempty"`
+	TrackNo int    `json:"trackNo,omitempty"` // Spends only; 0 queries every treasury track
+}
+
+type TreasuryProposalListingResponse struct {
+	Proposals  []TreasuryProposal `json:"proposals"`
+	TotalCount int                `json:"totalCount"`
 }
 
 type CreateTreasuryProposalRequest struct {
-	Value       string `json:"value"`
-	Beneficiary string `json:"beneficiary"`
-	Title       string `json:"title"`
-	Content     string `json:"content"`
+	Value       Balance `json:"value"`
+	Beneficiary string  `json:"beneficiary"`
+	Title       string  `json:"title"`
+	Content     string  `json:"content"`
 }
 
 // Tip types
@@ -517,8 +566,8 @@ type Tip struct {
 }
 
 type TipInfo struct {
-	Tipper string `json:"tipper"`
-	Value  string `json:"value"`
+	Tipper string  `json:"tipper"`
+	Value  Balance `json:"value"`
 }
 
 type CreateTipRequest struct {
@@ -527,6 +576,17 @@ type CreateTipRequest struct {
 	Who    string `json:"who"`
 }
 
+type TipListingParams struct {
+	Page   int    `json:"page,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+	Status string `json:"status,omitempty"` // e.g. "Opened", "Closed", "Retracted"
+}
+
+type TipListingResponse struct {
+	Tips       []Tip `json:"tips"`
+	TotalCount int   `json:"totalCount"`
+}
+
 // Discussion types
 type Discussion struct {
 	ID            int       `json:"id"`
@@ -540,12 +600,27 @@ type Discussion struct {
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
 	LastCommentAt time.Time `json:"last_comment_at,omitempty"`
+	TopicID       int       `json:"topicId,omitempty"`
 }
 
 type CreateDiscussionRequest struct {
 	Title   string   `json:"title"`
 	Content string   `json:"content"`
 	Tags    []string `json:"tags,omitempty"`
+	TopicID int      `json:"topicId,omitempty"`
+}
+
+type DiscussionListingParams struct {
+	Page    int      `json:"page,omitempty"`
+	Limit   int      `json:"limit,omitempty"`
+	TopicID int      `json:"topicId,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+	SortBy  string   `json:"sortBy,omitempty"`
+}
+
+type DiscussionListingResponse struct {
+	Discussions []Discussion `json:"discussions"`
+	TotalCount  int          `json:"totalCount"`
 }
 
 // Poll types
@@ -589,6 +664,18 @@ type Notification struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+type NotificationListingParams struct {
+	Page       int  `json:"page,omitempty"`
+	Limit      int  `json:"limit,omitempty"`
+	UnreadOnly bool `json:"unreadOnly,omitempty"`
+}
+
+type NotificationListingResponse struct {
+	Notifications []Notification `json:"notifications"`
+	TotalCount    int            `json:"totalCount"`
+	UnreadCount   int            `json:"unreadCount"`
+}
+
 type NotificationPreferences struct {
 	NewProposal          bool `json:"new_proposal"`
 	ProposalStatusChange bool `json:"proposal_status_change"`
@@ -627,14 +714,14 @@ type UserStat struct {
 
 // Network Stats
 type NetworkStats struct {
-	ActiveProposals       int    `json:"active_proposals"`
-	TotalProposals        int    `json:"total_proposals"`
-	TotalVotes            int    `json:"total_votes"`
-	TotalUsers            int    `json:"total_users"`
-	TotalDelegations      int    `json:"total_delegations"`
-	TotalDelegatedBalance string `json:"total_delegated_balance"`
-	WeeklyActiveUsers     int    `json:"weekly_active_users"`
-	MonthlyActiveUsers    int    `json:"monthly_active_users"`
+	ActiveProposals       int     `json:"active_proposals"`
+	TotalProposals        int     `json:"total_proposals"`
+	TotalVotes            int     `json:"total_votes"`
+	TotalUsers            int     `json:"total_users"`
+	TotalDelegations      int     `json:"total_delegations"`
+	TotalDelegatedBalance Balance `json:"total_delegated_balance"`
+	WeeklyActiveUsers     int     `json:"weekly_active_users"`
+	MonthlyActiveUsers    int     `json:"monthly_active_users"`
 }
 
 // Search types
@@ -661,6 +748,15 @@ type SearchResponse struct {
 	Limit      int       `json:"limit"`
 }
 
+// SearchResult is a single hit from a search; exactly one of Post, Comment
+// or User is set, matching Type.
+type SearchResult struct {
+	Type    string   `json:"type"` // "post", "comment" or "user"
+	Post    *Post    `json:"post,omitempty"`
+	Comment *Comment `json:"comment,omitempty"`
+	User    *User    `json:"user,omitempty"`
+}
+
 // Timeline types
 type TimelineEntry struct {
 	ID          int         `json:"id"`
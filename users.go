@@ -1,11 +1,16 @@
 package polkassembly
 
 import (
+	"context"
 	"fmt"
 )
 
 func (c *Client) GetUserByID(userID int) (*User, error) {
-	r, err := c.client.R().
+	return c.GetUserByIDCtx(context.Background(), userID)
+}
+
+func (c *Client) GetUserByIDCtx(ctx context.Context, userID int) (*User, error) {
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/users/id/%d", userID))
 	if err != nil {
 		return nil, err
@@ -20,6 +25,10 @@ func (c *Client) GetUserByID(userID int) (*User, error) {
 }
 
 func (c *Client) GetUserFollowing(userID int, page, limit int) (*UserListingResponse, error) {
+	return c.GetUserFollowingCtx(context.Background(), userID, page, limit)
+}
+
+func (c *Client) GetUserFollowingCtx(ctx context.Context, userID int, page, limit int) (*UserListingResponse, error) {
 	queryParams := map[string]string{}
 	if page > 0 {
 		queryParams["page"] = fmt.Sprintf("%d", page)
@@ -28,7 +37,7 @@ func (c *Client) GetUserFollowing(userID int, page, limit int) (*UserListingResp
 		queryParams["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetQueryParams(queryParams).
 		Get(fmt.Sprintf("/users/id/%d/following", userID))
 	if err != nil {
@@ -44,6 +53,10 @@ func (c *Client) GetUserFollowing(userID int, page, limit int) (*UserListingResp
 }
 
 func (c *Client) GetUserFollowers(userID int, page, limit int) (*UserListingResponse, error) {
+	return c.GetUserFollowersCtx(context.Background(), userID, page, limit)
+}
+
+func (c *Client) GetUserFollowersCtx(ctx context.Context, userID int, page, limit int) (*UserListingResponse, error) {
 	queryParams := map[string]string{}
 	if page > 0 {
 		queryParams["page"] = fmt.Sprintf("%d", page)
@@ -52,7 +65,7 @@ func (c *Client) GetUserFollowers(userID int, page, limit int) (*UserListingResp
 		queryParams["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetQueryParams(queryParams).
 		Get(fmt.Sprintf("/users/id/%d/followers", userID))
 	if err != nil {
@@ -68,6 +81,10 @@ func (c *Client) GetUserFollowers(userID int, page, limit int) (*UserListingResp
 }
 
 func (c *Client) GetUserActivity(userID int, page, limit int) (*UserActivity, error) {
+	return c.GetUserActivityCtx(context.Background(), userID, page, limit)
+}
+
+func (c *Client) GetUserActivityCtx(ctx context.Context, userID int, page, limit int) (*UserActivity, error) {
 	queryParams := map[string]string{}
 	if page > 0 {
 		queryParams["page"] = fmt.Sprintf("%d", page)
@@ -76,7 +93,7 @@ func (c *Client) GetUserActivity(userID int, page, limit int) (*UserActivity, er
 		queryParams["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetQueryParams(queryParams).
 		Get(fmt.Sprintf("/users/id/%d/activities", userID))
 	if err != nil {
@@ -92,7 +109,11 @@ func (c *Client) GetUserActivity(userID int, page, limit int) (*UserActivity, er
 }
 
 func (c *Client) GetUserByUsername(username string) (*User, error) {
-	r, err := c.client.R().
+	return c.GetUserByUsernameCtx(context.Background(), username)
+}
+
+func (c *Client) GetUserByUsernameCtx(ctx context.Context, username string) (*User, error) {
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/users/username/%s", username))
 	if err != nil {
 		return nil, err
@@ -107,7 +128,11 @@ func (c *Client) GetUserByUsername(username string) (*User, error) {
 }
 
 func (c *Client) GetUserByAddress(address string) (*User, error) {
-	r, err := c.client.R().
+	return c.GetUserByAddressCtx(context.Background(), address)
+}
+
+func (c *Client) GetUserByAddressCtx(ctx context.Context, address string) (*User, error) {
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/users/address/%s", address))
 	if err != nil {
 		return nil, err
@@ -122,6 +147,10 @@ func (c *Client) GetUserByAddress(address string) (*User, error) {
 }
 
 func (c *Client) GetUsers(params UserListingParams) (*UserListingResponse, error) {
+	return c.GetUsersCtx(context.Background(), params)
+}
+
+func (c *Client) GetUsersCtx(ctx context.Context, params UserListingParams) (*UserListingResponse, error) {
 	queryParams := map[string]string{}
 	if params.Page > 0 {
 		queryParams["page"] = fmt.Sprintf("%d", params.Page)
@@ -133,7 +162,7 @@ func (c *Client) GetUsers(params UserListingParams) (*UserListingResponse, error
 		queryParams["sort"] = params.Sort
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetQueryParams(queryParams).
 		Get("/users")
 	if err != nil {
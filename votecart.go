@@ -1,9 +1,16 @@
 package polkassembly
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 func (c *Client) GetCartItems(userID int) ([]CartItem, error) {
-	r, err := c.client.R().
+	return c.GetCartItemsCtx(context.Background(), userID)
+}
+
+func (c *Client) GetCartItemsCtx(ctx context.Context, userID int) ([]CartItem, error) {
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/users/id/%d/vote-cart", userID))
 
 	if err != nil {
@@ -30,8 +37,12 @@ func (c *Client) GetCartItems(userID int) ([]CartItem, error) {
 }
 
 func (c *Client) AddCartItem(userID int, req AddCartItemRequest) (*CartItem, error) {
+	return c.AddCartItemCtx(context.Background(), userID, req)
+}
+
+func (c *Client) AddCartItemCtx(ctx context.Context, userID int, req AddCartItemRequest) (*CartItem, error) {
 	var resp CartItem
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(req).
 		Post(fmt.Sprintf("/users/id/%d/vote-cart", userID))
 	if err != nil {
@@ -44,8 +55,12 @@ func (c *Client) AddCartItem(userID int, req AddCartItemRequest) (*CartItem, err
 }
 
 func (c *Client) UpdateCartItem(userID int, req UpdateCartItemRequest) (*CartItem, error) {
+	return c.UpdateCartItemCtx(context.Background(), userID, req)
+}
+
+func (c *Client) UpdateCartItemCtx(ctx context.Context, userID int, req UpdateCartItemRequest) (*CartItem, error) {
 	var resp CartItem
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetBody(req).
 		Patch(fmt.Sprintf("/users/id/%d/vote-cart", userID))
 	if err != nil {
@@ -58,7 +73,11 @@ func (c *Client) UpdateCartItem(userID int, req UpdateCartItemRequest) (*CartIte
 }
 
 func (c *Client) DeleteCartItem(userID int, itemID string) error {
-	r, err := c.client.R().
+	return c.DeleteCartItemCtx(context.Background(), userID, itemID)
+}
+
+func (c *Client) DeleteCartItemCtx(ctx context.Context, userID int, itemID string) error {
+	r, err := c.newRequest(ctx).
 		SetBody(map[string]string{"id": itemID}).
 		Delete(fmt.Sprintf("/users/id/%d/vote-cart", userID))
 	if err != nil {
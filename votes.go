@@ -1,18 +1,33 @@
 package polkassembly
 
 import (
+	"context"
 	"fmt"
 )
 
 // GetVotes retrieves votes for a specific proposal
 func (c *Client) GetVotes(params VoteListingParams) (*VoteListingResponse, error) {
-	return c.GetVotesByType(params, "ReferendumV2")
+	return c.GetVotesCtx(context.Background(), params)
+}
+
+// GetVotesCtx is like GetVotes but carries ctx for cancellation and deadlines
+func (c *Client) GetVotesCtx(ctx context.Context, params VoteListingParams) (*VoteListingResponse, error) {
+	return c.GetVotesByTypeCtx(ctx, params, ProposalTypeReferendumV2)
 }
 
 // GetVotesByType retrieves votes for a specific proposal type
-func (c *Client) GetVotesByType(params VoteListingParams, proposalType string) (*VoteListingResponse, error) {
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+func (c *Client) GetVotesByType(params VoteListingParams, proposalType ProposalType) (*VoteListingResponse, error) {
+	return c.GetVotesByTypeCtx(context.Background(), params, proposalType)
+}
+
+// GetVotesByTypeCtx is like GetVotesByType but carries ctx for cancellation and deadlines
+func (c *Client) GetVotesByTypeCtx(ctx context.Context, params VoteListingParams, proposalType ProposalType) (*VoteListingResponse, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+	if err := proposalType.requireCapability(proposalType.HasVotes(), "votes"); err != nil {
+		return nil, err
 	}
 
 	queryParams := make(map[string]string)
@@ -28,7 +43,7 @@ func (c *Client) GetVotesByType(params VoteListingParams, proposalType string) (
 
 	endpoint := fmt.Sprintf("/%s/%d/votes", proposalType, params.PostID)
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetQueryParams(queryParams).
 		Get(endpoint)
 	if err != nil {
@@ -44,9 +59,18 @@ func (c *Client) GetVotesByType(params VoteListingParams, proposalType string) (
 }
 
 // GetVotesByAddress retrieves votes by a specific address
-func (c *Client) GetVotesByAddress(proposalType string, postID int, address string, page, limit int) (*VoteListingResponse, error) {
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+func (c *Client) GetVotesByAddress(proposalType ProposalType, postID int, address string, page, limit int) (*VoteListingResponse, error) {
+	return c.GetVotesByAddressCtx(context.Background(), proposalType, postID, address, page, limit)
+}
+
+// GetVotesByAddressCtx is like GetVotesByAddress but carries ctx for cancellation and deadlines
+func (c *Client) GetVotesByAddressCtx(ctx context.Context, proposalType ProposalType, postID int, address string, page, limit int) (*VoteListingResponse, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+	if err := proposalType.requireCapability(proposalType.HasVotes(), "votes"); err != nil {
+		return nil, err
 	}
 
 	queryParams := map[string]string{}
@@ -57,7 +81,7 @@ func (c *Client) GetVotesByAddress(proposalType string, postID int, address stri
 		queryParams["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetQueryParams(queryParams).
 		Get(fmt.Sprintf("/%s/%d/votes/user/address/%s", proposalType, postID, address))
 	if err != nil {
@@ -73,9 +97,18 @@ func (c *Client) GetVotesByAddress(proposalType string, postID int, address stri
 }
 
 // GetVotesByUserID retrieves votes by a specific user ID
-func (c *Client) GetVotesByUserID(proposalType string, postID int, userID int, page, limit int) (*VoteListingResponse, error) {
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+func (c *Client) GetVotesByUserID(proposalType ProposalType, postID int, userID int, page, limit int) (*VoteListingResponse, error) {
+	return c.GetVotesByUserIDCtx(context.Background(), proposalType, postID, userID, page, limit)
+}
+
+// GetVotesByUserIDCtx is like GetVotesByUserID but carries ctx for cancellation and deadlines
+func (c *Client) GetVotesByUserIDCtx(ctx context.Context, proposalType ProposalType, postID int, userID int, page, limit int) (*VoteListingResponse, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+	if err := proposalType.requireCapability(proposalType.HasVotes(), "votes"); err != nil {
+		return nil, err
 	}
 
 	queryParams := map[string]string{}
@@ -86,7 +119,7 @@ func (c *Client) GetVotesByUserID(proposalType string, postID int, userID int, p
 		queryParams["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		SetQueryParams(queryParams).
 		Get(fmt.Sprintf("/%s/%d/votes/user/id/%d", proposalType, postID, userID))
 	if err != nil {
@@ -103,16 +136,30 @@ func (c *Client) GetVotesByUserID(proposalType string, postID int, userID int, p
 
 // GetVotingCurve retrieves voting curve data for a proposal
 func (c *Client) GetVotingCurve(postID int) ([]VotingCurveData, error) {
-	return c.GetVotingCurveByType(postID, "ReferendumV2")
+	return c.GetVotingCurveCtx(context.Background(), postID)
+}
+
+// GetVotingCurveCtx is like GetVotingCurve but carries ctx for cancellation and deadlines
+func (c *Client) GetVotingCurveCtx(ctx context.Context, postID int) ([]VotingCurveData, error) {
+	return c.GetVotingCurveByTypeCtx(ctx, postID, ProposalTypeReferendumV2)
 }
 
 // GetVotingCurveByType retrieves voting curve data for a specific proposal type
-func (c *Client) GetVotingCurveByType(postID int, proposalType string) ([]VotingCurveData, error) {
-	if proposalType == "" {
-		proposalType = "ReferendumV2"
+func (c *Client) GetVotingCurveByType(postID int, proposalType ProposalType) ([]VotingCurveData, error) {
+	return c.GetVotingCurveByTypeCtx(context.Background(), postID, proposalType)
+}
+
+// GetVotingCurveByTypeCtx is like GetVotingCurveByType but carries ctx for cancellation and deadlines
+func (c *Client) GetVotingCurveByTypeCtx(ctx context.Context, postID int, proposalType ProposalType) ([]VotingCurveData, error) {
+	proposalType, err := proposalType.orDefault(ProposalTypeReferendumV2)
+	if err != nil {
+		return nil, err
+	}
+	if err := proposalType.requireCapability(proposalType.HasCurves(), "vote curves"); err != nil {
+		return nil, err
 	}
 
-	r, err := c.client.R().
+	r, err := c.newRequest(ctx).
 		Get(fmt.Sprintf("/%s/%d/vote-curves", proposalType, postID))
 	if err != nil {
 		return nil, err
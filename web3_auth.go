@@ -1,6 +1,7 @@
 package polkassembly
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"time"
@@ -20,8 +21,14 @@ type Web3SignResponse struct {
 	Error   string `json:"error,omitempty"`
 }
 
-// AuthenticateWithSigner authenticates using a signer
+// AuthenticateWithSigner authenticates using a signer and keeps it to
+// re-authenticate when the token expires or is rejected
 func (c *Client) AuthenticateWithSigner(network string, signer Signer) error {
+	return c.AuthenticateWithSignerCtx(context.Background(), network, signer)
+}
+
+// AuthenticateWithSignerCtx is like AuthenticateWithSigner but carries ctx for cancellation and deadlines
+func (c *Client) AuthenticateWithSignerCtx(ctx context.Context, network string, signer Signer) error {
 	// Generate a message to sign
 	message := fmt.Sprintf("Sign this message to authenticate with Polkassembly\n\nNetwork: %s\nAddress: %s\nTimestamp: %d",
 		network, signer.Address(), time.Now().Unix())
@@ -39,23 +46,45 @@ func (c *Client) AuthenticateWithSigner(network string, signer Signer) error {
 		Message:   message,
 		Network:   network,
 	}
+	if ws, ok := signer.(WalletSigner); ok {
+		req.Wallet = ws.Wallet()
+	}
 
 	// Authenticate
-	resp, err := c.Web3Auth(req)
+	resp, err := c.Web3AuthCtx(ctx, req)
 	if err != nil {
 		return fmt.Errorf("web3 auth: %w", err)
 	}
 
+	// Sign in again with the same signer when the token expires
+	c.SetReauth(ReauthWithSigner(network, signer))
+
 	// Store the token
 	if resp.Token != "" {
-		c.SetAuthToken(resp.Token)
+		return c.SetAuthToken(resp.Token)
 	}
 
 	return nil
 }
 
-// AuthenticateWithSeed authenticates using a seed phrase
+// AuthenticateWithSeed authenticates using a seed phrase for an sr25519 account
 func (c *Client) AuthenticateWithSeed(network string, seedPhrase string) error {
+	return c.AuthenticateWithSeedCtx(context.Background(), network, seedPhrase)
+}
+
+// AuthenticateWithSeedCtx is like AuthenticateWithSeed but carries ctx for cancellation and deadlines
+func (c *Client) AuthenticateWithSeedCtx(ctx context.Context, network string, seedPhrase string) error {
+	return c.AuthenticateWithSeedSchemeCtx(ctx, network, seedPhrase, KeySchemeSr25519)
+}
+
+// AuthenticateWithSeedScheme authenticates using a seed phrase for an
+// account of the given key scheme
+func (c *Client) AuthenticateWithSeedScheme(network string, seedPhrase string, scheme KeyScheme) error {
+	return c.AuthenticateWithSeedSchemeCtx(context.Background(), network, seedPhrase, scheme)
+}
+
+// AuthenticateWithSeedSchemeCtx is like AuthenticateWithSeedScheme but carries ctx for cancellation and deadlines
+func (c *Client) AuthenticateWithSeedSchemeCtx(ctx context.Context, network string, seedPhrase string, scheme KeyScheme) error {
 	// Determine network ID for SS58 encoding
 	var networkID uint16
 	switch network {
@@ -68,10 +97,10 @@ func (c *Client) AuthenticateWithSeed(network string, seedPhrase string) error {
 	}
 
 	// Create signer
-	signer, err := NewPolkadotSignerFromSeed(seedPhrase, networkID)
+	signer, err := NewSignerFromSeed(seedPhrase, networkID, scheme)
 	if err != nil {
 		return fmt.Errorf("create signer: %w", err)
 	}
 
-	return c.AuthenticateWithSigner(network, signer)
+	return c.AuthenticateWithSignerCtx(ctx, network, signer)
 }